@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type attemptTimeoutCtxKey struct{}
+
+// WithAttemptTimeout stores the timeout of the current attempt of a retried operation into the context,
+// so it is written by the WithAttemptBudget event option on every log event created with the returned context.
+//
+// Example usage:
+//
+//	attemptCtx := logger.WithAttemptTimeout(ctx, 2*time.Second)
+//	logger.Retry(attemptCtx, attempt, 5, backoff, err).Msg("calling payment provider")
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the attempt timeout is stored.
+//	d (time.Duration): The timeout of the attempt.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the attempt timeout.
+func WithAttemptTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, attemptTimeoutCtxKey{}, d)
+}
+
+// WithAttemptBudget returns an event option that writes the 'attempt_timeout_ms' field, the timeout stored in the context
+// by WithAttemptTimeout capped by the context deadline, and the 'budget_remaining_ms' field, the time left until the context deadline.
+// Each field is omitted when the context does not carry its value.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.WithAttemptBudget())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the attempt budget fields.
+func WithAttemptBudget() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		timeout, hasTimeout := ctx.Value(attemptTimeoutCtxKey{}).(time.Duration)
+		deadline, hasDeadline := ctx.Deadline()
+
+		var remaining time.Duration
+		if hasDeadline {
+			remaining = time.Until(deadline)
+		}
+
+		if hasTimeout {
+			if hasDeadline {
+				timeout = min(timeout, remaining)
+			}
+			e = e.Int64("attempt_timeout_ms", timeout.Milliseconds())
+		}
+		if hasDeadline {
+			e = e.Int64("budget_remaining_ms", remaining.Milliseconds())
+		}
+
+		return e
+	}
+}
@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAttemptBudget(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(WithAttemptBudget())
+	})
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	t.Run("WithAttemptBudget when timeout and deadline are present should write both fields", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+		defer cancel()
+
+		Info(WithAttemptTimeout(ctx, 2*time.Second)).Msg("test")
+
+		f := fields()
+		assert.Equal(t, float64(2000), f["attempt_timeout_ms"])
+		assert.InDelta(t, 60000, f["budget_remaining_ms"], 1000)
+	})
+
+	t.Run("WithAttemptBudget when timeout exceeds the remaining budget should cap the timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+		defer cancel()
+
+		Info(WithAttemptTimeout(ctx, time.Minute)).Msg("test")
+
+		f := fields()
+		assert.LessOrEqual(t, f["attempt_timeout_ms"], float64(1000))
+		assert.Equal(t, f["budget_remaining_ms"], f["attempt_timeout_ms"])
+	})
+
+	t.Run("WithAttemptBudget when only the timeout is present should omit the remaining budget", func(t *testing.T) {
+		Info(WithAttemptTimeout(context.TODO(), 2*time.Second)).Msg("test")
+
+		f := fields()
+		assert.Equal(t, float64(2000), f["attempt_timeout_ms"])
+		assert.NotContains(t, f, "budget_remaining_ms")
+	})
+
+	t.Run("WithAttemptBudget when only the deadline is present should omit the attempt timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+		defer cancel()
+
+		Info(ctx).Msg("test")
+
+		f := fields()
+		assert.Contains(t, f, "budget_remaining_ms")
+		assert.NotContains(t, f, "attempt_timeout_ms")
+	})
+}
@@ -0,0 +1,38 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// WithBaggageLevelOverride lowers the level of the log events created with a context whose OpenTelemetry baggage
+// carries the given member, such as "log.level=debug", so every service propagating the baggage logs the request in detail
+// during distributed debug sessions. The override applies to the logger returned by FromContext, and so to the logging functions.
+// The level can only be lowered, so the baggage of a request can not silence its log events, and invalid values are ignored.
+//
+// Example usage:
+//
+//	cfg.WithBaggageLevelOverride("log.level")
+//
+// Params:
+//
+//	member (string): The key of the baggage member carrying the level.
+func (cfg *LoggerConfig) WithBaggageLevelOverride(member string) {
+	cfg.baggageLevel = member
+}
+
+// baggageLevel returns the level carried by the baggage member of the context, and whether it is valid.
+func baggageLevel(ctx context.Context, member string) (zerolog.Level, bool) {
+	value := baggage.FromContext(ctx).Member(member).Value()
+	if value == "" {
+		return zerolog.NoLevel, false
+	}
+
+	level, err := zerolog.ParseLevel(value)
+	if err != nil || level == zerolog.NoLevel {
+		return zerolog.NoLevel, false
+	}
+	return level, true
+}
@@ -0,0 +1,54 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+func TestWithBaggageLevelOverride(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithLevel(zerolog.InfoLevel)
+		cfg.WithBaggageLevelOverride("log.level")
+	})
+
+	withBaggage := func(value string) context.Context {
+		member, err := baggage.NewMember("log.level", value)
+		assert.NoError(t, err)
+		b, err := baggage.New(member)
+		assert.NoError(t, err)
+		return baggage.ContextWithBaggage(context.TODO(), b)
+	}
+
+	suts := map[string]struct {
+		ctx     context.Context
+		written bool
+	}{
+		"Debug when baggage sets the debug level should write the event":           {ctx: withBaggage("debug"), written: true},
+		"Debug when context does not carry the baggage should not write the event": {ctx: context.TODO()},
+		"Debug when baggage level is invalid should not write the event":           {ctx: withBaggage("verbose")},
+		"Debug when baggage level is higher should not write the event":            {ctx: withBaggage("error")},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff.Reset()
+			Debug(sut.ctx).Msg("debugging")
+
+			assert.Equal(t, sut.written, buff.Len() > 0)
+		})
+	}
+
+	t.Run("Info when baggage sets the error level should still write the event", func(t *testing.T) {
+		buff.Reset()
+		Info(withBaggage("error")).Msg("handled")
+
+		assert.Contains(t, buff.String(), "\"message\":\"handled\"")
+	})
+}
@@ -0,0 +1,208 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// BatchFlushFunc represents a function that sends a batch of rendered log events, one per element, to their destination.
+type BatchFlushFunc func(ctx context.Context, batch [][]byte) error
+
+type batchConfig struct {
+	flush       BatchFlushFunc
+	maxBatch    int
+	maxInterval time.Duration
+}
+
+// WithBatchWriter sets a batching writer as the output destination, replacing the writer set by WithWriter.
+// Rendered log events are accumulated and passed to flush in the background once maxBatch events are held
+// or maxInterval has elapsed, whichever comes first. A maxBatch below one is treated as one, and a maxInterval
+// of zero or less disables the interval, so log events are flushed by size, Sync and Shutdown only. A failed flush is retried once, and only then the batch
+// is discarded and its log events are counted by Dropped. Shutdown must be called before the program exits
+// so the held log events are flushed.
+//
+// Example usage:
+//
+//	cfg.WithBatchWriter(func(ctx context.Context, batch [][]byte) error {
+//	    return ingestion.Send(ctx, batch)
+//	}, 500, 2*time.Second)
+//
+// Params:
+//
+//	flush (BatchFlushFunc): The function sending each batch.
+//	maxBatch (int): The maximum number of log events in a batch.
+//	maxInterval (time.Duration): The maximum duration log events are held before being flushed.
+func (cfg *LoggerConfig) WithBatchWriter(flush BatchFlushFunc, maxBatch int, maxInterval time.Duration) {
+	cfg.batch = &batchConfig{flush: flush, maxBatch: max(maxBatch, 1), maxInterval: maxInterval}
+}
+
+type batchWriter struct {
+	flushFn  BatchFlushFunc
+	maxBatch int
+	mu       sync.Mutex
+	pending  [][]byte
+	closed   bool
+	loopID   atomic.Uint64 // The goroutine sending the batches, on which flush must not wait for itself.
+	full     chan struct{}
+	synced   chan chan struct{}
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func newBatchWriter(c *batchConfig) *batchWriter {
+	w := &batchWriter{
+		flushFn:  c.flush,
+		maxBatch: c.maxBatch,
+		full:     make(chan struct{}, 1),
+		synced:   make(chan chan struct{}),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go w.loop(c.maxInterval)
+	return w
+}
+
+func (w *batchWriter) Write(p []byte) (int, error) {
+	// The event buffer is reused by zerolog once the write returns.
+	entry := bytes.Clone(p)
+
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		w.send([][]byte{entry})
+		return len(p), nil
+	}
+
+	w.pending = append(w.pending, entry)
+	full := len(w.pending) >= w.maxBatch
+	w.mu.Unlock()
+
+	if full {
+		select {
+		case w.full <- struct{}{}:
+		default:
+		}
+	}
+
+	return len(p), nil
+}
+
+func (w *batchWriter) loop(interval time.Duration) {
+	defer close(w.done)
+	w.loopID.Store(goroutineID())
+
+	// A nil channel never receives, disabling the interval flushes.
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-w.full:
+			w.send(w.take())
+		case <-tick:
+			w.send(w.take())
+		case flushed := <-w.synced:
+			w.drain()
+			close(flushed)
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// take removes the held log events, up to the batch size.
+func (w *batchWriter) take() [][]byte {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	n := min(len(w.pending), w.maxBatch)
+	batch := w.pending[:n:n]
+	w.pending = w.pending[n:]
+
+	if len(w.pending) >= w.maxBatch {
+		select {
+		case w.full <- struct{}{}:
+		default:
+		}
+	}
+
+	return batch
+}
+
+// drain sends the held log events batch by batch until none is left.
+func (w *batchWriter) drain() {
+	for batch := w.take(); len(batch) > 0; batch = w.take() {
+		w.send(batch)
+	}
+}
+
+func (w *batchWriter) send(batch [][]byte) {
+	if len(batch) == 0 {
+		return
+	}
+
+	err := w.flushFn(context.Background(), batch)
+	if err != nil {
+		err = w.flushFn(context.Background(), batch)
+	}
+	if err != nil {
+		writeError(err)
+		for _, p := range batch {
+			dropRendered(DropWriteFailed, zerolog.NoLevel, p)
+		}
+	}
+}
+
+// Sync waits for all the held log events to be flushed, in as many batches as needed.
+func (w *batchWriter) Sync() error {
+	flushed := make(chan struct{})
+
+	select {
+	case w.synced <- flushed:
+		<-flushed
+	case <-w.done:
+	}
+
+	return nil
+}
+
+// flush sends all the held log events right away and waits for them. When the flush function itself logs an error,
+// on the goroutine sending the batches, the held log events are sent right after the current batch instead,
+// so flush never waits for itself.
+func (w *batchWriter) flush() {
+	if goroutineID() == w.loopID.Load() {
+		select {
+		case w.full <- struct{}{}:
+		default:
+		}
+		return
+	}
+	_ = w.Sync()
+}
+
+// Close flushes the held log events and stops the background flushes. Later writes are flushed one by one.
+func (w *batchWriter) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	w.mu.Unlock()
+
+	close(w.stop)
+	<-w.done
+
+	// Flushes the log events held beyond the last batch.
+	w.drain()
+	return nil
+}
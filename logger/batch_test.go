@@ -0,0 +1,127 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// batchRecorder records the messages of each flushed batch.
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches [][]string
+	err     error
+	calls   int
+}
+
+func (r *batchRecorder) flush(ctx context.Context, batch [][]byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls++
+	if r.err != nil {
+		return r.err
+	}
+
+	messages := []string{}
+	for _, p := range batch {
+		messages = append(messages, message(p))
+	}
+	r.batches = append(r.batches, messages)
+	return nil
+}
+
+func (r *batchRecorder) flushed() [][]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.batches
+}
+
+func TestWithBatchWriter(t *testing.T) {
+	configure := func(r *batchRecorder, maxBatch int, maxInterval time.Duration) {
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithBatchWriter(r.flush, maxBatch, maxInterval)
+		})
+	}
+
+	t.Run("WithBatchWriter when batch size is reached should flush the batch", func(t *testing.T) {
+		r := &batchRecorder{}
+		configure(r, 2, time.Hour)
+
+		Info(context.TODO()).Msg("first")
+		Info(context.TODO()).Msg("second")
+		Info(context.TODO()).Msg("third")
+
+		assert.Eventually(t, func() bool { return len(r.flushed()) == 1 }, time.Second, time.Millisecond)
+		assert.Equal(t, [][]string{{"first", "second"}}, r.flushed())
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+
+	t.Run("WithBatchWriter when interval elapses should flush the held events", func(t *testing.T) {
+		r := &batchRecorder{}
+		configure(r, 100, 20*time.Millisecond)
+
+		Info(context.TODO()).Msg("first")
+
+		assert.Eventually(t, func() bool { return len(r.flushed()) == 1 }, time.Second, time.Millisecond)
+		assert.Equal(t, [][]string{{"first"}}, r.flushed())
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+
+	t.Run("Shutdown when events are held should drain them", func(t *testing.T) {
+		r := &batchRecorder{}
+		configure(r, 2, time.Hour)
+
+		Info(context.TODO()).Msg("first")
+		Info(context.TODO()).Msg("second")
+		Info(context.TODO()).Msg("third")
+		assert.NoError(t, Shutdown(context.Background()))
+
+		assert.Equal(t, [][]string{{"first", "second"}, {"third"}}, r.flushed())
+	})
+
+	t.Run("Sync when more than a batch is held should flush all of them", func(t *testing.T) {
+		r := &batchRecorder{}
+		configure(r, 2, time.Hour)
+
+		for _, msg := range []string{"first", "second", "third", "fourth", "fifth"} {
+			Info(context.TODO()).Msg(msg)
+		}
+		assert.NoError(t, Sync())
+
+		flushed := []string{}
+		for _, batch := range r.flushed() {
+			flushed = append(flushed, batch...)
+		}
+		assert.Equal(t, []string{"first", "second", "third", "fourth", "fifth"}, flushed)
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+
+	t.Run("WithBatchWriter when batch size and interval are not positive should still flush the events", func(t *testing.T) {
+		r := &batchRecorder{}
+		configure(r, 0, 0)
+
+		Info(context.TODO()).Msg("first")
+		assert.NoError(t, Sync())
+
+		assert.Equal(t, [][]string{{"first"}}, r.flushed())
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+
+	t.Run("WithBatchWriter when flush fails twice should drop the batch", func(t *testing.T) {
+		r := &batchRecorder{err: errors.New("unavailable")}
+		configure(r, 2, time.Hour)
+		before := Dropped()
+
+		Info(context.TODO()).Msg("first")
+		Info(context.TODO()).Msg("second")
+		assert.NoError(t, Shutdown(context.Background()))
+
+		assert.Equal(t, 2, r.calls)
+		assert.Equal(t, before+2, Dropped())
+	})
+}
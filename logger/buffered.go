@@ -0,0 +1,147 @@
+package logger
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// WithBufferedWriter buffers up to size log events in memory, written to the output destination in the background.
+// When the buffer is full, writes block for up to maxBlock applying backpressure to the caller,
+// and only then the log event is dropped and counted by Dropped. Sync waits for the buffered log events to be written,
+// and Shutdown must be called before the program exits so they are not lost.
+//
+// Example usage:
+//
+//	cfg.WithBufferedWriter(1024, 10*time.Millisecond)
+//
+// Params:
+//
+//	size (int): The number of log events held by the buffer.
+//	maxBlock (time.Duration): The maximum duration a write blocks while the buffer is full.
+func (cfg *LoggerConfig) WithBufferedWriter(size int, maxBlock time.Duration) {
+	cfg.writerOptions = append(cfg.writerOptions, func(w zerolog.LevelWriter) zerolog.LevelWriter {
+		bw := newBufferedWriter(w, size, maxBlock)
+		cfg.closers = append(cfg.closers, bw)
+		cfg.flushers = append(cfg.flushers, bw)
+		return bw
+	})
+}
+
+type bufferedEntry struct {
+	level   zerolog.Level
+	p       []byte
+	flushed chan struct{} // Set by Sync, closed once the preceding entries are written.
+}
+
+type bufferedWriter struct {
+	w        zerolog.LevelWriter
+	maxBlock time.Duration
+	entries  chan bufferedEntry
+	done     chan struct{}
+	mu       sync.RWMutex
+	closed   bool
+	loopID   atomic.Uint64 // The goroutine writing the buffered log events, on which flush must not wait for itself.
+}
+
+func newBufferedWriter(w zerolog.LevelWriter, size int, maxBlock time.Duration) *bufferedWriter {
+	bw := &bufferedWriter{
+		w:        w,
+		maxBlock: maxBlock,
+		entries:  make(chan bufferedEntry, size),
+		done:     make(chan struct{}),
+	}
+	go bw.loop()
+	return bw
+}
+
+func (w *bufferedWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *bufferedWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.closed {
+		return w.w.WriteLevel(level, p)
+	}
+
+	// The event buffer is reused by zerolog once the write returns.
+	entry := bufferedEntry{level: level, p: bytes.Clone(p)}
+
+	select {
+	case w.entries <- entry:
+		return len(p), nil
+	default:
+	}
+
+	timer := time.NewTimer(w.maxBlock)
+	defer timer.Stop()
+
+	select {
+	case w.entries <- entry:
+	case <-timer.C:
+		dropRendered(DropBufferFull, level, p)
+	}
+
+	return len(p), nil
+}
+
+func (w *bufferedWriter) loop() {
+	defer close(w.done)
+	w.loopID.Store(goroutineID())
+
+	for entry := range w.entries {
+		if entry.flushed != nil {
+			close(entry.flushed)
+			continue
+		}
+		if _, err := w.w.WriteLevel(entry.level, entry.p); err != nil {
+			writeError(err)
+		}
+	}
+}
+
+// Sync waits for the buffered log events to be written and flushes the underlying writer.
+func (w *bufferedWriter) Sync() error {
+	w.flush()
+	return syncWriter(w.w)
+}
+
+// flush waits for the buffered log events to be written, without flushing the underlying writer.
+// It returns right away on the goroutine writing them, such as when the underlying writer logs an error.
+func (w *bufferedWriter) flush() {
+	if goroutineID() == w.loopID.Load() {
+		return
+	}
+
+	w.mu.RLock()
+	if w.closed {
+		w.mu.RUnlock()
+		return
+	}
+
+	flushed := make(chan struct{})
+	w.entries <- bufferedEntry{flushed: flushed}
+	w.mu.RUnlock()
+	<-flushed
+}
+
+// Close writes the buffered log events and stops the background writes. Later writes are not buffered.
+func (w *bufferedWriter) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	close(w.entries)
+	w.mu.Unlock()
+
+	<-w.done
+	return nil
+}
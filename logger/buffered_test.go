@@ -0,0 +1,102 @@
+package logger
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gatedWriter blocks every write until the gate is opened.
+type gatedWriter struct {
+	lockedBuffer
+	gate chan struct{}
+}
+
+func (w *gatedWriter) Write(p []byte) (int, error) {
+	<-w.gate
+	return w.lockedBuffer.Write(p)
+}
+
+func TestWithBufferedWriter(t *testing.T) {
+	configure := func(maxBlock time.Duration) *gatedWriter {
+		w := &gatedWriter{gate: make(chan struct{})}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(w)
+			cfg.WithBufferedWriter(1, maxBlock)
+		})
+
+		// The first event is held by the background write, the second one fills the buffer.
+		Info(context.TODO()).Msg("held")
+		Info(context.TODO()).Msg("buffered")
+		return w
+	}
+
+	t.Run("WithBufferedWriter when buffer is full should block up to the timeout and drop", func(t *testing.T) {
+		w := configure(50 * time.Millisecond)
+		before := Dropped()
+
+		start := time.Now()
+		Info(context.TODO()).Msg("dropped")
+		elapsed := time.Since(start)
+
+		close(w.gate)
+		assert.NoError(t, Shutdown(context.Background()))
+
+		assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+		assert.Equal(t, before+1, Dropped())
+		assert.Equal(t, 2, strings.Count(w.String(), "\"message\""))
+		assert.NotContains(t, w.String(), "\"message\":\"dropped\"")
+	})
+
+	t.Run("WithBufferedWriter when buffer is drained within the timeout should not drop", func(t *testing.T) {
+		w := configure(time.Second)
+		before := Dropped()
+
+		time.AfterFunc(20*time.Millisecond, func() { close(w.gate) })
+		Info(context.TODO()).Msg("blocked")
+		assert.NoError(t, Sync())
+
+		assert.Equal(t, before, Dropped())
+		assert.Contains(t, w.String(), "\"message\":\"blocked\"")
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+}
+
+// syncingWriter calls Sync from within its first write, as a writer logging its own errors would.
+type syncingWriter struct {
+	lockedBuffer
+	synced chan error
+}
+
+func (w *syncingWriter) Write(p []byte) (int, error) {
+	select {
+	case w.synced <- Sync():
+	default:
+	}
+	return w.lockedBuffer.Write(p)
+}
+
+func TestWithBufferedWriterSyncFromLoop(t *testing.T) {
+	t.Run("Sync when called from the background write should not deadlock", func(t *testing.T) {
+		w := &syncingWriter{synced: make(chan error, 1)}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(w)
+			cfg.WithBufferedWriter(1, time.Second)
+		})
+
+		Info(context.TODO()).Msg("first")
+		Info(context.TODO()).Msg("second")
+
+		select {
+		case err := <-w.synced:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("Sync deadlocked on the background write")
+		}
+		assert.NoError(t, Shutdown(context.Background()))
+		assert.Contains(t, w.String(), "second")
+	})
+}
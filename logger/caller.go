@@ -0,0 +1,133 @@
+package logger
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// packagePrefix is the prefix of the fully qualified names of the functions of this package.
+var packagePrefix = packagePath(runtime.FuncForPC(reflect.ValueOf(packagePath).Pointer()).Name()) + "."
+
+// maxCallerDepth is the number of frames walked by callerFrame looking for a frame outside this package.
+const maxCallerDepth = 32
+
+// callerFrames caches, by program counter, the first frame outside this package among the frames of the program counter,
+// which holds more than one when functions are inlined.
+var callerFrames sync.Map
+
+type callerCtxKey struct{}
+
+type cachedFrame struct {
+	frame runtime.Frame
+	ok    bool
+}
+
+// callerFrame returns the first frame of the calling goroutine outside this package, that is the frame that called
+// the logging function, however many functions of this package, such as Entry methods or Retry, are in between.
+// Functions declared in test files are not considered part of the package.
+func callerFrame() (runtime.Frame, bool) {
+	var pcs [maxCallerDepth]uintptr
+	n := runtime.Callers(2, pcs[:])
+
+	for _, pc := range pcs[:n] {
+		cached, ok := callerFrames.Load(pc)
+		if !ok {
+			cached = resolveFrame(pc)
+			callerFrames.Store(pc, cached)
+		}
+		if c := cached.(cachedFrame); c.ok {
+			return c.frame, true
+		}
+	}
+	return runtime.Frame{}, false
+}
+
+func resolveFrame(pc uintptr) cachedFrame {
+	frames := runtime.CallersFrames([]uintptr{pc})
+	for {
+		frame, more := frames.Next()
+		if !packageFrame(frame) {
+			return cachedFrame{frame: frame, ok: true}
+		}
+		if !more {
+			return cachedFrame{}
+		}
+	}
+}
+
+// packageFrame reports whether the frame belongs to a function of this package, excluding its tests.
+func packageFrame(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, packagePrefix) && !strings.HasSuffix(frame.File, "_test.go")
+}
+
+// WithCallerPackage writes the 'pkg' field, the import path of the package that created the log event.
+// Unlike the full file and line, the package is a low-cardinality value suitable for grouping log events.
+// Resolved program counters are cached, so the frame is symbolized once per call site.
+//
+// Example usage:
+//
+//	cfg.WithCallerPackage()
+//	logger.Info(ctx).Msg("order created") // "pkg":"github.com/acme/shop/orders"
+func (cfg *LoggerConfig) WithCallerPackage() {
+	cfg.callers = true
+	cfg.WithEventFields(func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		if function := callerFunction(ctx); function != "" {
+			return e.Str("pkg", packagePath(function))
+		}
+		return e
+	})
+}
+
+// WithCallerFunction writes the 'caller_func' field, the fully qualified name of the function that created the log event,
+// such as "github.com/acme/shop/orders.(*Service).Create", for navigating from log viewers to the code.
+// Resolved program counters are cached, so the frame is symbolized once per call site.
+//
+// Example usage:
+//
+//	cfg.WithCallerFunction()
+//	logger.Info(ctx).Msg("order created") // "caller_func":"github.com/acme/shop/orders.(*Service).Create"
+func (cfg *LoggerConfig) WithCallerFunction() {
+	cfg.callers = true
+	cfg.WithEventFields(func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		if function := callerFunction(ctx); function != "" {
+			return e.Str("caller_func", function)
+		}
+		return e
+	})
+}
+
+// withCaller stores the frame that created the log event into the context passed to the event options,
+// resolved before they run, since WithOptionTimeout runs them on another goroutine.
+func withCaller(ctx context.Context) context.Context {
+	frame, ok := callerFrame()
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, callerCtxKey{}, frame.Function)
+}
+
+// callerFunction returns the fully qualified name of the function that created the log event,
+// the first one outside this package.
+func callerFunction(ctx context.Context) string {
+	if function, ok := ctx.Value(callerCtxKey{}).(string); ok {
+		return function
+	}
+
+	frame, _ := callerFrame()
+	return frame.Function
+}
+
+// packagePath returns the package path of a fully qualified function name,
+// such as "github.com/acme/shop/orders.(*Service).Create".
+func packagePath(function string) string {
+	slash := strings.LastIndex(function, "/")
+	if dot := strings.Index(function[slash+1:], "."); dot >= 0 {
+		return function[:slash+1+dot]
+	}
+	return function
+}
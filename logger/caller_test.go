@@ -0,0 +1,113 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCallerPackage(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithCallerPackage()
+	})
+
+	pkg := func() any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields["pkg"]
+	}
+
+	t.Run("Info when caller package is enabled should write the package of the caller", func(t *testing.T) {
+		Info(context.TODO()).Msg("test")
+
+		assert.Equal(t, "github.com/mitz-it/go-toolkit/logger", pkg())
+	})
+
+	t.Run("Err when call site is cached should write the same package", func(t *testing.T) {
+		for range 2 {
+			Err(context.TODO(), errFingerprint).Msg("test")
+
+			assert.Equal(t, "github.com/mitz-it/go-toolkit/logger", pkg())
+		}
+	})
+
+	t.Run("Entry when caller package is enabled should write the package of the caller", func(t *testing.T) {
+		NewEntry(context.TODO()).Info().Msg("test")
+
+		assert.Equal(t, "github.com/mitz-it/go-toolkit/logger", pkg())
+	})
+}
+
+func TestCallerFrame(t *testing.T) {
+	t.Run("callerFrame when called through package functions should return the first frame outside the package", func(t *testing.T) {
+		var frame runtime.Frame
+		func() {
+			frame, _ = callerFrame()
+		}()
+
+		assert.Regexp(t, `^github\.com/mitz-it/go-toolkit/logger\.TestCallerFrame\.func\d+\.1$`, frame.Function)
+	})
+
+	t.Run("packageFrame when frame is outside the package should return false", func(t *testing.T) {
+		assert.False(t, packageFrame(runtime.Frame{Function: "github.com/mitz-it/go-toolkit/loggerx.Info"}))
+		assert.True(t, packageFrame(runtime.Frame{Function: "github.com/mitz-it/go-toolkit/logger.(*Entry).Info", File: "entry.go"}))
+	})
+}
+
+func TestWithCallerFunction(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithCallerFunction()
+	})
+
+	t.Run("Info when caller function is enabled should write the function of the caller", func(t *testing.T) {
+		Info(context.TODO()).Msg("test")
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		assert.Regexp(t, `^github\.com/mitz-it/go-toolkit/logger\.TestWithCallerFunction\.func\d+$`, fields["caller_func"])
+	})
+
+	t.Run("Entry when caller function is enabled should write the function of the caller", func(t *testing.T) {
+		buff.Reset()
+		NewEntry(context.TODO()).Info().Msg("test")
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		assert.Regexp(t, `^github\.com/mitz-it/go-toolkit/logger\.TestWithCallerFunction\.func\d+$`, fields["caller_func"])
+	})
+
+	t.Run("Info when event options run under a timeout should write the function of the caller", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithCallerFunction()
+			cfg.WithOptionTimeout(time.Second)
+		})
+
+		Info(context.TODO()).Msg("test")
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		assert.Regexp(t, `^github\.com/mitz-it/go-toolkit/logger\.TestWithCallerFunction\.func\d+$`, fields["caller_func"])
+	})
+}
+
+func TestPackagePath(t *testing.T) {
+	t.Run("packagePath when function is a method should return the package path", func(t *testing.T) {
+		assert.Equal(t, "github.com/acme/shop/orders", packagePath("github.com/acme/shop/orders.(*Service).Create"))
+	})
+
+	t.Run("packagePath when package has no slash should return the package", func(t *testing.T) {
+		assert.Equal(t, "main", packagePath("main.main.func1"))
+	})
+}
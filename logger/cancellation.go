@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// WatchCancellation logs a single "warn" event when the context is done before the returned stop function is called,
+// including the 'operation' field, the context error and the 'reason' field ("canceled" or "deadline_exceeded").
+// The stop function must be called once the operation completes, releasing the watching goroutine.
+//
+// Example usage:
+//
+//	stop := logger.WatchCancellation(ctx, "generate-report")
+//	defer stop()
+//
+// Params:
+//
+//	ctx (context.Context): The context of the operation being watched.
+//	operation (string): The name of the operation being watched.
+//
+// Returns:
+//
+//	func(): The function stopping the watch, which waits for the watching goroutine to return.
+func WatchCancellation(ctx context.Context, operation string) func() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		select {
+		case <-ctx.Done():
+			reason := "canceled"
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				reason = "deadline_exceeded"
+			}
+			Warn(ctx).Str("operation", operation).Str("reason", reason).Err(ctx.Err()).Msg("operation cancelled")
+		case <-stop:
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(stop) })
+		<-done
+	}
+}
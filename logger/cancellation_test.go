@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchCancellation(t *testing.T) {
+	buff := &lockedBuffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+	logged := func() bool { return buff.String() != "" }
+
+	t.Run("WatchCancellation when context is cancelled should log the cancellation", func(t *testing.T) {
+		buff.Reset()
+		ctx, cancel := context.WithCancel(context.TODO())
+		stop := WatchCancellation(ctx, "generate-report")
+
+		cancel()
+		assert.Eventually(t, logged, time.Second, time.Millisecond)
+		stop()
+
+		msg := buff.String()
+		assert.Contains(t, msg, "\"level\":\"warn\"")
+		assert.Contains(t, msg, "\"operation\":\"generate-report\"")
+		assert.Contains(t, msg, "\"reason\":\"canceled\"")
+		assert.Contains(t, msg, "\"error\":\"context canceled\"")
+	})
+
+	t.Run("WatchCancellation when deadline is exceeded should log the deadline", func(t *testing.T) {
+		buff.Reset()
+		ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond)
+		defer cancel()
+		stop := WatchCancellation(ctx, "generate-report")
+
+		assert.Eventually(t, logged, time.Second, time.Millisecond)
+		stop()
+
+		msg := buff.String()
+		assert.Contains(t, msg, "\"reason\":\"deadline_exceeded\"")
+		assert.Contains(t, msg, "\"error\":\"context deadline exceeded\"")
+	})
+
+	t.Run("WatchCancellation when operation completes should not log", func(t *testing.T) {
+		buff.Reset()
+		ctx, cancel := context.WithCancel(context.TODO())
+		stop := WatchCancellation(ctx, "generate-report")
+
+		stop()
+		cancel()
+
+		assert.Empty(t, buff.String())
+	})
+}
@@ -0,0 +1,46 @@
+package logger
+
+import "github.com/rs/zerolog"
+
+// CappedArray writes the first sample items as the key array, keeping the lines of log events carrying large arrays small.
+// The length of items is written as the '{key}_total' field, and the '{key}_truncated' field is set to true
+// when items exceed the sample. Empty slices are written as an empty array.
+//
+// Example usage:
+//
+//	logger.CappedArray(logger.Info(ctx), "order_ids", ids, 3).Msg("orders exported")
+//	// "order_ids":[1,2,3],"order_ids_total":250,"order_ids_truncated":true
+//
+// Params:
+//
+//	e (*zerolog.Event): The log event.
+//	key (string): The key of the array.
+//	items ([]any): The items of the array.
+//	sample (int): The maximum number of items written.
+//
+// Returns:
+//
+//	*zerolog.Event: The log event with the capped array.
+func CappedArray(e *zerolog.Event, key string, items []any, sample int) *zerolog.Event {
+	if !e.Enabled() {
+		return e
+	}
+
+	total := len(items)
+	sample = max(sample, 0)
+	truncated := total > sample
+	if truncated {
+		items = items[:sample]
+	}
+
+	arr := zerolog.Arr()
+	for _, item := range items {
+		arr = arr.Interface(item)
+	}
+
+	e = e.Array(key, arr).Int(key+"_total", total)
+	if truncated {
+		e = e.Bool(key+"_truncated", true)
+	}
+	return e
+}
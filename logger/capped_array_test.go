@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCappedArray(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	t.Run("CappedArray when items exceed the sample should write the sample, total and truncated flag", func(t *testing.T) {
+		CappedArray(Info(context.TODO()), "ids", []any{1, 2, 3, 4, 5}, 2).Msg("test")
+
+		f := fields()
+		assert.Equal(t, []any{float64(1), float64(2)}, f["ids"])
+		assert.Equal(t, float64(5), f["ids_total"])
+		assert.Equal(t, true, f["ids_truncated"])
+	})
+
+	t.Run("CappedArray when items fit the sample should write every item without truncated flag", func(t *testing.T) {
+		CappedArray(Info(context.TODO()), "ids", []any{"a", "b"}, 5).Msg("test")
+
+		f := fields()
+		assert.Equal(t, []any{"a", "b"}, f["ids"])
+		assert.Equal(t, float64(2), f["ids_total"])
+		assert.NotContains(t, f, "ids_truncated")
+	})
+
+	t.Run("CappedArray when items are empty should write an empty array", func(t *testing.T) {
+		CappedArray(Info(context.TODO()), "ids", nil, 5).Msg("test")
+
+		f := fields()
+		assert.Equal(t, []any{}, f["ids"])
+		assert.Equal(t, float64(0), f["ids_total"])
+	})
+}
@@ -0,0 +1,121 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// Capture collects the log events created with a context returned by WithTestCapture, for assertions in tests.
+type Capture struct {
+	mu     sync.Mutex
+	events []map[string]any
+}
+
+// WithTestCapture stores a logger capturing its log events into the context, derived from the context logger,
+// so tests can assert that a code path logged a particular event. The log events created with the returned context,
+// and the contexts derived from it, are captured and still written to the configured writer,
+// while the log events of other contexts are not captured.
+// Since WithContext starts from the global logger, it must not be called after WithTestCapture on the same context.
+//
+// Example usage:
+//
+//	ctx, capture := logger.WithTestCapture(context.Background())
+//	service.Checkout(ctx, order)
+//	assert.True(t, capture.Contains(zerolog.WarnLevel, "payment retried"))
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the parent logger.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the capturing logger.
+//	*Capture: The log events captured.
+func WithTestCapture(ctx context.Context) (context.Context, *Capture) {
+	c := &Capture{}
+
+	return withOutput(ctx, func(w io.Writer) io.Writer {
+		return &captureWriter{w: w, c: c}
+	}), c
+}
+
+// Contains reports whether a log event of the given level, whose message contains msgSubstring, was captured.
+//
+// Params:
+//
+//	level (zerolog.Level): The level of the log event.
+//	msgSubstring (string): The text contained in the message of the log event.
+//
+// Returns:
+//
+//	bool: Whether a matching log event was captured.
+func (c *Capture) Contains(level zerolog.Level, msgSubstring string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, e := range c.events {
+		msg, _ := e[zerolog.MessageFieldName].(string)
+		if e[zerolog.LevelFieldName] == level.String() && strings.Contains(msg, msgSubstring) {
+			return true
+		}
+	}
+	return false
+}
+
+// Fields returns the fields of the first captured log event with the given message, or nil if there is none.
+// Values are decoded from JSON, so numbers are float64, objects are map[string]any and arrays are []any.
+//
+// Params:
+//
+//	msg (string): The message of the log event.
+//
+// Returns:
+//
+//	map[string]any: The fields of the log event, by key.
+func (c *Capture) Fields(msg string) map[string]any {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, e := range c.events {
+		if e[zerolog.MessageFieldName] == msg {
+			return e
+		}
+	}
+	return nil
+}
+
+func (c *Capture) add(p []byte) {
+	var fields map[string]any
+	if err := json.Unmarshal(bytes.TrimSpace(p), &fields); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = append(c.events, fields)
+}
+
+// captureWriter adds each log event to the capture before writing it.
+type captureWriter struct {
+	w io.Writer
+	c *Capture
+}
+
+func (w *captureWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *captureWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	w.c.add(p)
+	return writeLevel(w.w, level, p)
+}
+
+func (w *captureWriter) Sync() error {
+	return syncWriter(w.w)
+}
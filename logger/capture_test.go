@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTestCapture(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	t.Run("WithTestCapture when logging with the context and its children should capture the events", func(t *testing.T) {
+		ctx, capture := WithTestCapture(context.TODO())
+
+		Warn(ctx).Str("order_id", "42").Msg("payment retried")
+		Info(Derive(ctx, func(c zerolog.Context) zerolog.Context {
+			return c.Str("step", "charge")
+		})).Msg("charging")
+
+		assert.True(t, capture.Contains(zerolog.WarnLevel, "retried"))
+		assert.False(t, capture.Contains(zerolog.InfoLevel, "retried"))
+		assert.True(t, capture.Contains(zerolog.InfoLevel, "charging"))
+		assert.Equal(t, "42", capture.Fields("payment retried")["order_id"])
+		assert.Equal(t, "charge", capture.Fields("charging")["step"])
+		assert.Contains(t, buff.String(), "\"message\":\"payment retried\"")
+	})
+
+	t.Run("WithTestCapture when logging with other contexts should not capture the events", func(t *testing.T) {
+		_, capture := WithTestCapture(context.TODO())
+		other, _ := WithTestCapture(context.TODO())
+
+		Info(context.TODO()).Msg("unrelated")
+		Info(other).Msg("other capture")
+
+		assert.False(t, capture.Contains(zerolog.InfoLevel, "unrelated"))
+		assert.False(t, capture.Contains(zerolog.InfoLevel, "other capture"))
+		assert.Nil(t, capture.Fields("unrelated"))
+	})
+
+	t.Run("WithTestCapture when redaction policy is derived should keep capturing", func(t *testing.T) {
+		ctx, capture := WithTestCapture(context.TODO())
+
+		Info(WithRedactionPolicy(ctx, []string{"email"})).Str("email", "jane@example.com").Msg("user updated")
+
+		assert.True(t, capture.Contains(zerolog.InfoLevel, "user updated"))
+	})
+}
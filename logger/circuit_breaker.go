@@ -0,0 +1,159 @@
+package logger
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// BreakerState represents the state of the writer circuit breaker.
+type BreakerState int
+
+const (
+	BreakerClosed   BreakerState = iota // Log events are written.
+	BreakerOpen                         // Log events are dropped until the cooldown elapses.
+	BreakerHalfOpen                     // A single log event is written to test the recovery of the writer.
+)
+
+// String returns the name of the breaker state.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// WithWriterCircuitBreaker stops writing log events once the writer fails failureThreshold consecutive times,
+// such as a degraded remote sink, so its latency does not cascade into the callers. While the breaker is open,
+// log events are dropped and counted by Dropped. Once the cooldown elapses, the breaker is half-open and the next
+// log event is written to test the writer, closing the breaker on success or opening it again for another cooldown.
+// The current state is returned by WriterBreakerState.
+//
+// Example usage:
+//
+//	cfg.WithWriterCircuitBreaker(5, 30*time.Second)
+//
+// Params:
+//
+//	failureThreshold (int): The number of consecutive write errors opening the breaker.
+//	cooldown (time.Duration): The duration the breaker stays open before testing the writer.
+func (cfg *LoggerConfig) WithWriterCircuitBreaker(failureThreshold int, cooldown time.Duration) {
+	cfg.writerOptions = append(cfg.writerOptions, func(w zerolog.LevelWriter) zerolog.LevelWriter {
+		b := &breakerWriter{w: w, threshold: failureThreshold, cooldown: cooldown}
+		// The breaker of the writer chain of the configured format is reported, rather than the ones of WithFormat.
+		if cfg.breaker == nil {
+			cfg.breaker = b
+		}
+		return b
+	})
+}
+
+// WriterBreakerState returns the current state of the breaker set by WithWriterCircuitBreaker,
+// or BreakerClosed when it is not set.
+//
+// Example usage:
+//
+//	if logger.WriterBreakerState() == logger.BreakerOpen {
+//	    health.Degraded("logging")
+//	}
+//
+// Returns:
+//
+//	BreakerState: The current state of the breaker.
+func WriterBreakerState() BreakerState {
+	cfg := config()
+	if cfg.breaker == nil {
+		return BreakerClosed
+	}
+	return cfg.breaker.current()
+}
+
+type breakerWriter struct {
+	w         zerolog.LevelWriter
+	threshold int
+	cooldown  time.Duration
+	mu        sync.Mutex
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	probing   bool // Whether the test write of the half-open breaker is in flight.
+}
+
+func (w *breakerWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *breakerWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	if !w.allow() {
+		dropRendered(DropBreaker, level, p)
+		return len(p), nil
+	}
+
+	n, err := w.w.WriteLevel(level, p)
+	w.record(err)
+
+	return n, err
+}
+
+// allow reports whether the log event is written, moving the open breaker to half-open once the cooldown elapses.
+func (w *breakerWriter) allow() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.state == BreakerOpen && time.Since(w.openedAt) >= w.cooldown {
+		w.state = BreakerHalfOpen
+	}
+
+	switch w.state {
+	case BreakerOpen:
+		return false
+	case BreakerHalfOpen:
+		if w.probing {
+			return false
+		}
+		w.probing = true
+	}
+	return true
+}
+
+func (w *breakerWriter) record(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	switch w.state {
+	case BreakerOpen:
+		// Writes started before the breaker opened do not change it.
+		return
+	case BreakerHalfOpen:
+		w.probing = false
+	}
+
+	if err == nil {
+		w.state, w.failures = BreakerClosed, 0
+		return
+	}
+
+	w.failures++
+	if w.state == BreakerHalfOpen || w.failures >= w.threshold {
+		w.state, w.openedAt = BreakerOpen, time.Now()
+	}
+}
+
+func (w *breakerWriter) current() BreakerState {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.state == BreakerOpen && time.Since(w.openedAt) >= w.cooldown {
+		return BreakerHalfOpen
+	}
+	return w.state
+}
+
+func (w *breakerWriter) Sync() error {
+	return syncWriter(w.w)
+}
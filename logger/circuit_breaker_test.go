@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithWriterCircuitBreaker(t *testing.T) {
+	w := &failingWriter{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(w)
+		cfg.WithWriterCircuitBreaker(2, 50*time.Millisecond)
+	})
+	zerolog.ErrorHandler = func(err error) {}
+	t.Cleanup(func() {
+		zerolog.ErrorHandler = nil
+	})
+
+	t.Run("WithWriterCircuitBreaker when writes fail consecutively should open the breaker and drop", func(t *testing.T) {
+		w.fail = true
+		Info(context.TODO()).Msg("first")
+		assert.Equal(t, BreakerClosed, WriterBreakerState())
+		Info(context.TODO()).Msg("second")
+		assert.Equal(t, BreakerOpen, WriterBreakerState())
+
+		w.fail = false
+		before := Dropped()
+		Info(context.TODO()).Msg("dropped")
+
+		assert.Equal(t, before+1, Dropped())
+		assert.Empty(t, w.String())
+	})
+
+	t.Run("WithWriterCircuitBreaker when cooldown elapses and writer recovers should close the breaker", func(t *testing.T) {
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, BreakerHalfOpen, WriterBreakerState())
+
+		Info(context.TODO()).Msg("recovered")
+
+		assert.Equal(t, BreakerClosed, WriterBreakerState())
+		assert.Contains(t, w.String(), "\"message\":\"recovered\"")
+	})
+
+	t.Run("WithWriterCircuitBreaker when test write fails should open the breaker again", func(t *testing.T) {
+		w.fail = true
+		Info(context.TODO()).Msg("first")
+		Info(context.TODO()).Msg("second")
+		time.Sleep(50 * time.Millisecond)
+
+		Info(context.TODO()).Msg("test write")
+
+		assert.Equal(t, BreakerOpen, WriterBreakerState())
+	})
+
+	t.Run("WriterBreakerState when breaker is not set should be closed", func(t *testing.T) {
+		Configure()
+
+		assert.Equal(t, BreakerClosed, WriterBreakerState())
+		assert.Equal(t, "closed", WriterBreakerState().String())
+	})
+}
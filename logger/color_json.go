@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+var levelColors = map[zerolog.Level]string{
+	zerolog.TraceLevel: "\x1b[35m",
+	zerolog.DebugLevel: "\x1b[33m",
+	zerolog.InfoLevel:  "\x1b[32m",
+	zerolog.WarnLevel:  "\x1b[31m",
+	zerolog.ErrorLevel: "\x1b[1;31m",
+	zerolog.FatalLevel: "\x1b[1;31m",
+	zerolog.PanicLevel: "\x1b[1;31m",
+}
+
+const colorReset = "\x1b[0m"
+
+// WithColorizedJSON colorizes the level value of the FormatJSON log events written to terminals,
+// keeping the JSON structure so the output is still valid JSON once the ANSI escape codes are stripped.
+// Writers that are not terminals, such as pipes and files, receive plain JSON, as do all writers when
+// the NO_COLOR environment variable is set. Writers report being terminals by being a character device *os.File,
+// or by implementing IsTerminal() bool.
+//
+// Example usage:
+//
+//	cfg.WithColorizedJSON() // "level":\x1b[32m"info"\x1b[0m on a terminal.
+func (cfg *LoggerConfig) WithColorizedJSON() {
+	cfg.colorizedJSON = true
+}
+
+func isTerminal(w io.Writer) bool {
+	switch t := w.(type) {
+	case interface{ IsTerminal() bool }:
+		return t.IsTerminal()
+	case *os.File:
+		info, err := t.Stat()
+		return err == nil && info.Mode()&os.ModeCharDevice != 0
+	}
+	return false
+}
+
+func colorize(w io.Writer) bool {
+	_, noColor := os.LookupEnv("NO_COLOR")
+	return !noColor && isTerminal(w)
+}
+
+// colorJSONWriter wraps the level value of each JSON rendered log event in the ANSI color of its level.
+type colorJSONWriter struct {
+	w io.Writer
+}
+
+func (w *colorJSONWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *colorJSONWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	key := []byte(`"` + zerolog.LevelFieldName + `":`)
+
+	start := bytes.Index(p, key)
+	if start < 0 {
+		return writeLevel(w.w, level, p)
+	}
+	start += len(key)
+
+	end := bytes.IndexByte(p[start+1:], '"')
+	if p[start] != '"' || end < 0 {
+		return writeLevel(w.w, level, p)
+	}
+	end += start + 2
+
+	value := p[start:end]
+	parsed, err := zerolog.ParseLevel(string(value[1 : len(value)-1]))
+	color, ok := levelColors[parsed]
+	if err != nil || !ok {
+		return writeLevel(w.w, level, p)
+	}
+
+	buf := make([]byte, 0, len(p)+len(color)+len(colorReset))
+	buf = append(buf, p[:start]...)
+	buf = append(buf, color...)
+	buf = append(buf, value...)
+	buf = append(buf, colorReset...)
+	buf = append(buf, p[end:]...)
+
+	if _, err := writeLevel(w.w, level, buf); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *colorJSONWriter) Sync() error {
+	return syncWriter(w.w)
+}
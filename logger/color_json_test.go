@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ttyBuffer is a buffer reporting itself as a terminal.
+type ttyBuffer struct {
+	bytes.Buffer
+}
+
+func (b *ttyBuffer) IsTerminal() bool { return true }
+
+var ansi = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+func TestWithColorizedJSON(t *testing.T) {
+	suts := map[string]struct {
+		w interface {
+			io.Writer
+			String() string
+		}
+		noColor bool
+		colored bool
+	}{
+		"WithColorizedJSON when writer is a terminal should colorize the level": {w: &ttyBuffer{}, colored: true},
+		"WithColorizedJSON when writer is a pipe should not colorize the level": {w: &bytes.Buffer{}, colored: false},
+		"WithColorizedJSON when NO_COLOR is set should not colorize the level":  {w: &ttyBuffer{}, noColor: true, colored: false},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			if sut.noColor {
+				t.Setenv("NO_COLOR", "1")
+			} else if _, ok := os.LookupEnv("NO_COLOR"); ok {
+				t.Setenv("NO_COLOR", "")
+				os.Unsetenv("NO_COLOR")
+			}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(sut.w)
+				cfg.WithColorizedJSON()
+			})
+
+			Error(context.TODO()).Str("order_id", "42").Msg("colored")
+			out := sut.w.String()
+
+			if sut.colored {
+				assert.Contains(t, out, "\"level\":\x1b[1;31m\"error\"\x1b[0m")
+			} else {
+				assert.NotContains(t, out, "\x1b[")
+			}
+			assert.True(t, json.Valid([]byte(ansi.ReplaceAllString(out, ""))))
+		})
+	}
+}
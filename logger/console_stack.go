@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// consoleWriter returns the FormatConsole encoder, which writes the 'stack' field, such as the one of errors
+// logged with zerolog.ErrorStackMarshaler, as an indented multi-line trace after the log line instead of inline.
+func (cfg *LoggerConfig) consoleWriter(w io.Writer) io.Writer {
+	cw := zerolog.ConsoleWriter{Out: w, FieldsExclude: cfg.consoleExclude, PartsOrder: cfg.consoleParts}
+
+	if !slices.Contains(cfg.consoleExclude, zerolog.ErrorStackFieldName) {
+		cw.FieldsExclude = append(slices.Clip(cfg.consoleExclude), zerolog.ErrorStackFieldName)
+		cw.FormatExtra = formatConsoleStack
+	}
+
+	if _, ok := w.(zerolog.LevelWriter); ok {
+		return consoleLevelWriter{cw: cw}
+	}
+	return cw
+}
+
+// consoleLevelWriter renders log events with the console writer, which only calls Write, keeping their level
+// for the output destinations relying on it, such as the syslog writer mapping it to the severity.
+type consoleLevelWriter struct {
+	cw zerolog.ConsoleWriter
+}
+
+func (w consoleLevelWriter) Write(p []byte) (int, error) {
+	return w.cw.Write(p)
+}
+
+func (w consoleLevelWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	cw := w.cw
+	cw.Out = levelOut{w: cw.Out, level: level}
+	return cw.Write(p)
+}
+
+func (w consoleLevelWriter) Sync() error {
+	return syncWriter(w.cw.Out)
+}
+
+// levelOut writes to a level writer at a fixed level.
+type levelOut struct {
+	w     io.Writer
+	level zerolog.Level
+}
+
+func (w levelOut) Write(p []byte) (int, error) {
+	return writeLevel(w.w, w.level, p)
+}
+
+// formatConsoleStack writes each frame of the 'stack' field on its own indented line.
+// Frames are objects with the 'func', 'source' or 'file', and 'line' fields, or strings written as they are.
+func formatConsoleStack(evt map[string]any, buf *bytes.Buffer) error {
+	switch stack := evt[zerolog.ErrorStackFieldName].(type) {
+	case []any:
+		for _, frame := range stack {
+			buf.WriteString("\n    ")
+			buf.WriteString(consoleFrame(frame))
+		}
+	case string:
+		for _, line := range strings.Split(strings.TrimSpace(stack), "\n") {
+			buf.WriteString("\n    ")
+			buf.WriteString(line)
+		}
+	case nil:
+	default:
+		fmt.Fprintf(buf, "\n    %v", stack)
+	}
+	return nil
+}
+
+func consoleFrame(frame any) string {
+	f, ok := frame.(map[string]any)
+	if !ok {
+		return fmt.Sprint(frame)
+	}
+
+	file := f["source"]
+	if file == nil {
+		file = f["file"]
+	}
+	return fmt.Sprintf("at %v (%v:%v)", f["func"], file, f["line"])
+}
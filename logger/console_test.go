@@ -0,0 +1,104 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithConsoleExcludeFields(t *testing.T) {
+	suts := map[string]struct {
+		format   Format
+		excluded bool
+	}{
+		"WithConsoleExcludeFields when format is console should hide the field": {format: FormatConsole, excluded: true},
+		"WithConsoleExcludeFields when format is JSON should keep the field":    {format: FormatJSON, excluded: false},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithFormat(sut.format)
+				cfg.WithConsoleExcludeFields("trace_id")
+			})
+
+			Info(context.TODO()).Str("trace_id", "4bf92f35").Str("order_id", "42").Msg("excluded")
+
+			assert.Contains(t, buff.String(), "42")
+			if sut.excluded {
+				assert.NotContains(t, buff.String(), "trace_id")
+				assert.NotContains(t, buff.String(), "4bf92f35")
+			} else {
+				assert.Contains(t, buff.String(), "\"trace_id\":\"4bf92f35\"")
+			}
+		})
+	}
+}
+
+func TestWithConsolePartsOrder(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithFormat(FormatConsole)
+		cfg.WithConsolePartsOrder([]string{"message", "unknown", "level"})
+	})
+
+	t.Run("WithConsolePartsOrder when order is set should render the parts in order", func(t *testing.T) {
+		Info(context.TODO()).Str("order_id", "42").Msg("order created")
+
+		line := buff.String()
+		assert.Contains(t, line, "order created")
+		assert.Less(t, strings.Index(line, "order created"), strings.Index(line, "INF"))
+		assert.Less(t, strings.Index(line, "INF"), strings.Index(line, "order_id"))
+		assert.NotContains(t, line, "unknown")
+	})
+}
+
+func TestConsoleStack(t *testing.T) {
+	stack := []map[string]any{
+		{"func": "main.charge", "source": "payment.go", "line": "42"},
+		{"func": "main.main", "source": "main.go", "line": "12"},
+	}
+
+	t.Run("Error when format is console should write the stack frames on their own lines", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithFormat(FormatConsole)
+		})
+
+		Error(context.TODO()).Interface("stack", stack).Msg("payment failed")
+
+		assert.Contains(t, buff.String(), "payment failed")
+		assert.Contains(t, buff.String(), "\n    at main.charge (payment.go:42)\n    at main.main (main.go:12)\n")
+		assert.NotContains(t, buff.String(), "stack=")
+	})
+
+	t.Run("Error when format is JSON should keep the stack array", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+		})
+
+		Error(context.TODO()).Interface("stack", stack).Msg("payment failed")
+
+		assert.Contains(t, buff.String(), "\"stack\":[{\"func\":\"main.charge\"")
+	})
+
+	t.Run("Error when format is console and stack is absent should write a single line", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithFormat(FormatConsole)
+		})
+
+		Error(context.TODO()).Msg("payment failed")
+
+		assert.Equal(t, 1, strings.Count(buff.String(), "\n"))
+	})
+}
@@ -0,0 +1,163 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+type loggerCtxKey struct{}
+
+type outputCtxKey struct{}
+
+// WithContext stores a logger derived from the global logger into the context, applying the given context options.
+// The logging functions use the logger stored in the context, so every log event created with the returned context carries the fields.
+// The stored logger always starts from the global logger, replacing any logger previously stored in the context.
+// A new sequence counter, written by the WithSequence event option, is also stored into the context.
+//
+// Example usage:
+//
+//	ctx = logger.WithContext(ctx, func(c zerolog.Context) zerolog.Context {
+//	    return c.Str("request_id", requestID)
+//	})
+//	logger.Info(ctx).Msg("request received") // Includes the 'request_id' field.
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the logger is stored.
+//	opts (...logger.LoggerContextOption): Optional functions that modifies zerolog.Context for additional contextual logging setup.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the logger.
+func WithContext(ctx context.Context, opts ...LoggerContextOption) context.Context {
+	cfg := config()
+	logCtx := cfg.root.With()
+
+	for _, opt := range opts {
+		logCtx = opt(logCtx)
+	}
+
+	return withLogger(withSequence(ctx), logCtx.Logger())
+}
+
+// Derive stores a logger derived from the context logger into the context, applying the given context options.
+// Unlike WithContext, the stored logger inherits the fields of the logger previously stored in the context,
+// falling back to the global logger, enabling hierarchical enrichment of child operations.
+// The parent context logger is not changed. The sequence counter of WithSequence is inherited from the parent context,
+// or started when the parent context has none.
+//
+// Example usage:
+//
+//	childCtx := logger.Derive(ctx, func(c zerolog.Context) zerolog.Context {
+//	    return c.Str("step", "charge")
+//	})
+//	logger.Info(childCtx).Msg("charging") // Includes the parent fields and the 'step' field.
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the parent logger.
+//	fields (...logger.LoggerContextOption): Optional functions that modifies zerolog.Context for additional contextual logging setup.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the derived logger.
+func Derive(ctx context.Context, fields ...LoggerContextOption) context.Context {
+	parent := FromContext(ctx)
+	logCtx := parent.With()
+
+	for _, opt := range fields {
+		logCtx = opt(logCtx)
+	}
+
+	if _, ok := ctx.Value(sequenceCtxKey{}).(*atomic.Uint64); !ok {
+		ctx = withSequence(ctx)
+	}
+
+	return withLogger(ctx, logCtx.Logger())
+}
+
+// Begin stores a logger derived from the global logger into the context, applying the given context options, and returns both.
+// It combines WithContext and FromContext, so middlewares can store the request logger and use it right away.
+//
+// Example usage:
+//
+//	ctx, l := logger.Begin(r.Context(), func(c zerolog.Context) zerolog.Context {
+//	    return c.Str("request_id", requestID)
+//	})
+//	l.Info().Msg("request received") // Includes the 'request_id' field.
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the logger is stored.
+//	fields (...logger.LoggerContextOption): Optional functions that modifies zerolog.Context for additional contextual logging setup.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the logger.
+//	zerolog.Logger: The logger stored in the context.
+func Begin(ctx context.Context, fields ...LoggerContextOption) (context.Context, zerolog.Logger) {
+	ctx = WithContext(ctx, fields...)
+	return ctx, FromContext(ctx)
+}
+
+// FromContext returns the logger stored in the context by WithContext, or the global logger if there is none.
+// The level of the returned logger is lowered when the context baggage overrides it, as set by WithBaggageLevelOverride.
+//
+// Example usage:
+//
+//	l := logger.FromContext(ctx)
+//	l.Info().Msg("using the context logger directly")
+//
+// Params:
+//
+//	ctx (context.Context): The context from which the logger is retrieved.
+//
+// Returns:
+//
+//	zerolog.Logger: The logger stored in the context or the global logger.
+func FromContext(ctx context.Context) zerolog.Logger {
+	cfg := config()
+	l, ok := ctx.Value(loggerCtxKey{}).(zerolog.Logger)
+	if !ok {
+		l = cfg.root
+	}
+
+	if cfg.baggageLevel != "" {
+		if level, ok := baggageLevel(ctx, cfg.baggageLevel); ok {
+			// The context is kept on the logger, so the level hook also finds the override in its log events.
+			return l.With().Ctx(ctx).Logger().Sample(levelGate{samplers: cfg.samplers, override: &level})
+		}
+	}
+
+	return l
+}
+
+func withLogger(ctx context.Context, l zerolog.Logger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey{}, l)
+}
+
+// withOutput stores a logger derived from the context logger into the context, writing through wrap before the configured writer.
+// The wrappers inherited from the parent context are kept, since the global writer is wrapped again rather than the parent logger writer.
+func withOutput(ctx context.Context, wrap func(w io.Writer) io.Writer) context.Context {
+	inherited, _ := ctx.Value(outputCtxKey{}).([]func(w io.Writer) io.Writer)
+	wraps := append(inherited[:len(inherited):len(inherited)], wrap)
+
+	return wrapOutput(context.WithValue(ctx, outputCtxKey{}, wraps))
+}
+
+// wrapOutput stores a logger derived from the context logger into the context, writing through the wrappers stored by withOutput
+// before the writer chain of the context format.
+func wrapOutput(ctx context.Context) context.Context {
+	cfg := config()
+	wraps, _ := ctx.Value(outputCtxKey{}).([]func(w io.Writer) io.Writer)
+
+	w := cfg.output(contextFormat(ctx))
+	for _, wrap := range wraps {
+		w = wrap(w)
+	}
+
+	return withLogger(ctx, FromContext(ctx).Output(w))
+}
@@ -0,0 +1,104 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"github.com/rs/zerolog"
+)
+
+type boundErrCtxKey struct{}
+
+// contextErrorFieldName is the internal field carrying the context error, renamed to the 'error' field
+// by the contextErrorWriter unless the log event already has an explicit error.
+const contextErrorFieldName = "_context_error"
+
+var contextErrorField = []byte(`"` + contextErrorFieldName + `":`)
+
+// WithError stores the error into the context, so it is attached by WithContextError to the log events at the "error"
+// level or above created with the returned context without an explicit error, such as the deepest error of layered handlers.
+// Storing a nil error clears the error inherited from the parent context.
+//
+// Example usage:
+//
+//	ctx = logger.WithError(ctx, err)
+//	logger.Error(ctx).Msg("request failed") // "error":"connection refused"
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the error is stored.
+//	err (error): The error bound to the context, or nil to clear it.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the error.
+func WithError(ctx context.Context, err error) context.Context {
+	return context.WithValue(ctx, boundErrCtxKey{}, err)
+}
+
+// WithContextError attaches the error stored in the context by WithError as the 'error' field of the log events
+// at the "error" level or above. Errors passed explicitly, such as to Err or to the Err method of the event, take precedence over the context error.
+//
+// Example usage:
+//
+//	cfg.WithContextError()
+func (cfg *LoggerConfig) WithContextError() {
+	cfg.contextErrors = true
+
+	cfg.hooks = append(cfg.hooks, zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+		if level < zerolog.ErrorLevel {
+			return
+		}
+
+		ctx := e.GetCtx()
+		if _, explicit := ctx.Value(errCtxKey{}).(error); explicit {
+			return
+		}
+		if err, ok := ctx.Value(boundErrCtxKey{}).(error); ok && err != nil {
+			// Fields such as the one set by Err are not readable from the event, so the precedence is resolved at write time.
+			e.AnErr(contextErrorFieldName, err)
+		}
+	}))
+}
+
+// contextErrorWriter writes the context error as the 'error' field of the log events without an explicit error,
+// dropping it otherwise. Log events without a context error are written unchanged.
+type contextErrorWriter struct {
+	w zerolog.LevelWriter
+}
+
+func (w *contextErrorWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *contextErrorWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	if !bytes.Contains(p, contextErrorField) {
+		return w.w.WriteLevel(level, p)
+	}
+
+	r, err := decodeRecord(level, p)
+	if err != nil {
+		return w.w.WriteLevel(level, p)
+	}
+	defer releaseRecord(r)
+
+	// The key may only appear nested, such as in a Dict, leaving the log event unchanged.
+	i := slices.IndexFunc(r.fields, func(f field) bool { return f.key == contextErrorFieldName })
+	if i < 0 {
+		return w.w.WriteLevel(level, p)
+	}
+	if _, explicit := r.get(zerolog.ErrorFieldName); !explicit {
+		r.fields[i].key = zerolog.ErrorFieldName
+	}
+	r.fields = slices.DeleteFunc(r.fields, func(f field) bool { return f.key == contextErrorFieldName })
+
+	if _, err := w.w.WriteLevel(level, r.encode()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *contextErrorWriter) Sync() error {
+	return syncWriter(w.w)
+}
@@ -0,0 +1,96 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithContextError(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithContextError()
+	})
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	ctx := WithError(context.TODO(), errors.New("connection refused"))
+
+	t.Run("Error when context carries an error should attach it", func(t *testing.T) {
+		Error(ctx).Msg("request failed")
+
+		assert.Equal(t, "connection refused", fields()["error"])
+	})
+
+	t.Run("Info when context carries an error should not attach it", func(t *testing.T) {
+		Info(ctx).Msg("request handled")
+
+		assert.NotContains(t, fields(), "error")
+	})
+
+	t.Run("Err when error is explicit should take precedence over the context error", func(t *testing.T) {
+		Err(ctx, errors.New("timeout")).Msg("request failed")
+
+		assert.Equal(t, "timeout", fields()["error"])
+	})
+
+	t.Run("Error when error is set on the event should write it once", func(t *testing.T) {
+		Error(ctx).Err(errors.New("timeout")).Msg("request failed")
+
+		assert.Equal(t, 1, strings.Count(buff.String(), `"error":`))
+		assert.Equal(t, "timeout", fields()["error"])
+	})
+
+	t.Run("Info when a nested field is named as the context error should write the event unchanged", func(t *testing.T) {
+		Info(context.TODO()).Dict("payload", zerolog.Dict().Str(contextErrorFieldName, "nested")).Msg("request handled")
+
+		f := fields()
+		assert.Equal(t, map[string]any{contextErrorFieldName: "nested"}, f["payload"])
+		assert.NotContains(t, f, "error")
+	})
+
+	t.Run("WithError when error is nil should clear the context error", func(t *testing.T) {
+		Error(WithError(ctx, nil)).Msg("request failed")
+
+		assert.NotContains(t, fields(), "error")
+	})
+}
+
+func TestFatalContextError(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithExitFunc(func(int) {})
+	})
+
+	ctx := WithError(context.TODO(), errors.New("connection refused"))
+
+	t.Run("Fatal when error is set on the event should write it once", func(t *testing.T) {
+		buff.Reset()
+		Fatal(ctx).Err(errors.New("timeout")).Msg("fatal message")
+
+		assert.Equal(t, 1, strings.Count(buff.String(), `"error":`))
+		assert.Contains(t, buff.String(), `"error":"timeout"`)
+		assert.NotContains(t, buff.String(), contextErrorFieldName)
+	})
+
+	t.Run("Fatal when context carries an error should attach it", func(t *testing.T) {
+		buff.Reset()
+		Fatal(ctx).Msg("fatal message")
+
+		assert.Contains(t, buff.String(), `"error":"connection refused"`)
+		assert.NotContains(t, buff.String(), contextErrorFieldName)
+	})
+}
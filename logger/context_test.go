@@ -0,0 +1,138 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithContext(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	ctx := WithContext(context.TODO(), func(c zerolog.Context) zerolog.Context {
+		return c.Str("request_id", "123")
+	})
+
+	t.Run("Info when context carries a logger should write the logger fields", func(t *testing.T) {
+		buff.Reset()
+		Info(ctx).Msg("request log")
+		assert.Contains(t, buff.String(), "\"request_id\":\"123\"")
+	})
+
+	t.Run("FromContext when context carries a logger should return it", func(t *testing.T) {
+		buff.Reset()
+		l := FromContext(ctx)
+		l.Info().Msg("request log")
+		assert.Contains(t, buff.String(), "\"request_id\":\"123\"")
+	})
+
+	t.Run("Info when context does not carry a logger should use the global logger", func(t *testing.T) {
+		buff.Reset()
+		Info(context.TODO()).Msg("global log")
+		assert.NotContains(t, buff.String(), "request_id")
+		assert.Contains(t, buff.String(), "\"message\":\"global log\"")
+	})
+}
+
+func TestDerive(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	parent := WithContext(context.TODO(), func(c zerolog.Context) zerolog.Context {
+		return c.Str("request_id", "123")
+	})
+	child := Derive(parent, func(c zerolog.Context) zerolog.Context {
+		return c.Str("step", "charge")
+	})
+
+	t.Run("Derive when context carries a logger should inherit its fields", func(t *testing.T) {
+		buff.Reset()
+		Info(child).Msg("child log")
+		assert.Contains(t, buff.String(), "\"request_id\":\"123\"")
+		assert.Contains(t, buff.String(), "\"step\":\"charge\"")
+	})
+
+	t.Run("Derive when child is enriched should not change the parent logger", func(t *testing.T) {
+		buff.Reset()
+		Info(parent).Msg("parent log")
+		assert.Contains(t, buff.String(), "\"request_id\":\"123\"")
+		assert.NotContains(t, buff.String(), "step")
+	})
+
+	t.Run("Derive when context does not carry a logger should derive from the global logger", func(t *testing.T) {
+		buff.Reset()
+		Info(Derive(context.TODO(), func(c zerolog.Context) zerolog.Context {
+			return c.Str("step", "charge")
+		})).Msg("child log")
+		assert.Contains(t, buff.String(), "\"step\":\"charge\"")
+		assert.NotContains(t, buff.String(), "request_id")
+	})
+
+	t.Run("Derive when goroutines fan out should not share the fields of their siblings", func(t *testing.T) {
+		buff := &lockedBuffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+		})
+		parent := WithContext(context.TODO(), func(c zerolog.Context) zerolog.Context {
+			return c.Str("request_id", "123")
+		})
+
+		var wg sync.WaitGroup
+		for i := range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ctx := Derive(parent, func(c zerolog.Context) zerolog.Context {
+					return c.Int("worker", i)
+				})
+				ctx = Derive(ctx, func(c zerolog.Context) zerolog.Context {
+					return c.Str(fmt.Sprintf("field_%d", i), "set")
+				})
+				Info(ctx).Msg("worker log")
+			}()
+		}
+		wg.Wait()
+		Info(parent).Msg("parent log")
+
+		lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+		assert.Len(t, lines, 9)
+		for _, line := range lines[:8] {
+			assert.Contains(t, line, "\"request_id\":\"123\"")
+			assert.Equal(t, 1, strings.Count(line, "\"worker\""), line)
+			assert.Equal(t, 1, strings.Count(line, "field_"), line)
+		}
+		assert.NotContains(t, lines[8], "worker")
+	})
+}
+
+func TestBegin(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	ctx, l := Begin(context.TODO(), func(c zerolog.Context) zerolog.Context {
+		return c.Str("request_id", "123")
+	})
+
+	t.Run("Begin when fields are given should return a logger carrying them", func(t *testing.T) {
+		buff.Reset()
+		l.Info().Msg("returned logger")
+		assert.Contains(t, buff.String(), "\"request_id\":\"123\"")
+	})
+
+	t.Run("Begin when fields are given should store the returned logger into the context", func(t *testing.T) {
+		assert.Equal(t, l, FromContext(ctx))
+	})
+}
@@ -0,0 +1,154 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	correlationChainHeader    = "X-Correlation-Chain"
+	maxCorrelationChainLength = 16
+)
+
+type upstreamCtxKey struct{}
+
+type requestIDCtxKey struct{}
+
+// WithUpstream appends the request ID of an upstream service to the correlation chain inherited by the context,
+// so the CorrelationChainField event option writes the lineage of the request on every log event created with the returned context.
+// The chain keeps the 16 most recent request IDs. An empty ID does not change the context.
+//
+// Example usage:
+//
+//	ctx = logger.WithUpstream(ctx, msg.Headers["request_id"])
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the inherited correlation chain.
+//	upstreamID (string): The request ID of the upstream service.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the correlation chain.
+func WithUpstream(ctx context.Context, upstreamID string) context.Context {
+	if upstreamID == "" {
+		return ctx
+	}
+
+	upstream, _ := ctx.Value(upstreamCtxKey{}).([]string)
+
+	// The chain is copied, so it is not shared with the parent context.
+	return context.WithValue(ctx, upstreamCtxKey{}, capChain(append(upstream[:len(upstream):len(upstream)], upstreamID)))
+}
+
+// WithRequestID stores the request ID of the local service into the context,
+// appended to the inherited upstream chain by the CorrelationChainField event option.
+//
+// Example usage:
+//
+//	ctx = logger.WithRequestID(ctx, uuid.NewString())
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the request ID is stored.
+//	id (string): The request ID of the local service.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDCtxKey{}, id)
+}
+
+// CorrelationChain returns the upstream chain carried by the context followed by the local request ID,
+// to be propagated to downstream services, such as through the 'X-Correlation-Chain' header joined by commas.
+//
+// Example usage:
+//
+//	req.Header.Set("X-Correlation-Chain", strings.Join(logger.CorrelationChain(ctx), ","))
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the correlation chain.
+//
+// Returns:
+//
+//	[]string: The request IDs, from the oldest upstream to the local one.
+func CorrelationChain(ctx context.Context) []string {
+	upstream, _ := ctx.Value(upstreamCtxKey{}).([]string)
+	chain := append([]string{}, upstream...)
+
+	if id, _ := ctx.Value(requestIDCtxKey{}).(string); id != "" {
+		chain = append(chain, id)
+	}
+
+	return capChain(chain)
+}
+
+// CorrelationChainField returns an event option that writes the chain returned by CorrelationChain as the 'correlation_chain' field.
+// Log events created with a context without upstream nor local request IDs are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.CorrelationChainField())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the correlation chain field.
+func CorrelationChainField() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		chain := CorrelationChain(ctx)
+		if len(chain) == 0 {
+			return e
+		}
+		return e.Strs("correlation_chain", chain)
+	}
+}
+
+// WithCorrelationChain makes the HTTP middleware store the chain of the 'X-Correlation-Chain' request header,
+// request IDs joined by commas, into the request context with WithUpstream, and the value of the requestIDHeader
+// request header as the local request ID with WithRequestID. IDs are truncated to 256 bytes to prevent abuse.
+//
+// Example usage:
+//
+//	handler := logger.HTTPMiddleware(logger.WithCorrelationChain("X-Request-ID"))(mux)
+//
+// Params:
+//
+//	requestIDHeader (string): The request header carrying the local request ID.
+//
+// Returns:
+//
+//	HTTPMiddlewareOption: The option to be passed to HTTPMiddleware.
+func WithCorrelationChain(requestIDHeader string) HTTPMiddlewareOption {
+	return func(cfg *httpMiddlewareConfig) {
+		cfg.requestIDHeader = requestIDHeader
+		cfg.correlationChain = true
+	}
+}
+
+// correlationContext returns a copy of the request context carrying the correlation chain of the request headers.
+func correlationContext(r *http.Request, requestIDHeader string) context.Context {
+	ctx := r.Context()
+
+	for _, id := range strings.Split(r.Header.Get(correlationChainHeader), ",") {
+		ctx = WithUpstream(ctx, truncate(strings.TrimSpace(id), maxHeaderTagLength))
+	}
+
+	if id := r.Header.Get(requestIDHeader); id != "" {
+		ctx = WithRequestID(ctx, truncate(id, maxHeaderTagLength))
+	}
+
+	return ctx
+}
+
+// capChain keeps the most recent request IDs of the chain.
+func capChain(chain []string) []string {
+	if len(chain) > maxCorrelationChainLength {
+		return chain[len(chain)-maxCorrelationChainLength:]
+	}
+	return chain
+}
@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorrelationChainField(t *testing.T) {
+	buff := &lockedBuffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(CorrelationChainField())
+	})
+
+	chain := func(line string) any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(line), &fields))
+		return fields["correlation_chain"]
+	}
+
+	t.Run("HTTPMiddleware when request crosses two hops should grow the chain", func(t *testing.T) {
+		buff.Reset()
+
+		var header string
+		serviceB := HTTPMiddleware(WithCorrelationChain("X-Request-ID"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Info(r.Context()).Msg("service b")
+			header = strings.Join(CorrelationChain(r.Context()), ",")
+		}))
+
+		ctx := WithRequestID(context.TODO(), "a")
+		Info(ctx).Msg("service a")
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Correlation-Chain", strings.Join(CorrelationChain(ctx), ","))
+		req.Header.Set("X-Request-ID", "b")
+		serviceB.ServeHTTP(httptest.NewRecorder(), req)
+
+		lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+		assert.Equal(t, []any{"a"}, chain(lines[0]))
+		assert.Equal(t, []any{"a", "b"}, chain(lines[1]))
+		assert.Equal(t, "a,b", header)
+	})
+
+	t.Run("WithUpstream when chain exceeds the limit should keep the most recent IDs", func(t *testing.T) {
+		ctx := context.TODO()
+		for i := range maxCorrelationChainLength + 4 {
+			ctx = WithUpstream(ctx, fmt.Sprint(i))
+		}
+
+		got := CorrelationChain(WithRequestID(ctx, "local"))
+		assert.Len(t, got, maxCorrelationChainLength)
+		assert.Equal(t, "5", got[0])
+		assert.Equal(t, "local", got[len(got)-1])
+	})
+
+	t.Run("WithUpstream when contexts share a parent should not leak the chain", func(t *testing.T) {
+		parent := WithUpstream(context.TODO(), "a")
+		first := WithUpstream(parent, "b")
+		second := WithUpstream(parent, "c")
+
+		assert.Equal(t, []string{"a"}, CorrelationChain(parent))
+		assert.Equal(t, []string{"a", "b"}, CorrelationChain(first))
+		assert.Equal(t, []string{"a", "c"}, CorrelationChain(second))
+	})
+
+	t.Run("CorrelationChainField when context does not carry a chain should not write the field", func(t *testing.T) {
+		buff.Reset()
+		Info(context.TODO()).Msg("test")
+
+		assert.NotContains(t, buff.String(), "correlation_chain")
+	})
+}
@@ -0,0 +1,38 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// WithDeadlineWarning raises info, debug and trace log events to the "warn" level and adds the 'near_deadline' field
+// when the remaining time until the context deadline is under the threshold, as an early signal of impending timeouts.
+// Log events created with a context without a deadline are not changed.
+//
+// Example usage:
+//
+//	cfg.WithDeadlineWarning(100 * time.Millisecond) // Warns when less than 100ms are left.
+//
+// Params:
+//
+//	threshold (time.Duration): The remaining time under which log events are raised.
+func (cfg *LoggerConfig) WithDeadlineWarning(threshold time.Duration) {
+	cfg.levelResolvers = append(cfg.levelResolvers, func(ctx context.Context, level zerolog.Level) (zerolog.Level, func(e *zerolog.Event) *zerolog.Event) {
+		// Evaluated once per log event, so the level and the field always agree.
+		if !nearDeadline(ctx, threshold) {
+			return level, nil
+		}
+		return max(level, zerolog.WarnLevel), markNearDeadline
+	})
+}
+
+func markNearDeadline(e *zerolog.Event) *zerolog.Event {
+	return e.Bool("near_deadline", true)
+}
+
+func nearDeadline(ctx context.Context, threshold time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return ok && time.Until(deadline) < threshold
+}
@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDeadlineWarning(t *testing.T) {
+	suts := map[string]struct {
+		ctx    func() (context.Context, context.CancelFunc)
+		assert func(t *testing.T, msg string)
+	}{
+		"WithDeadlineWarning when deadline is under the threshold should raise the event to warn": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.TODO(), 10*time.Millisecond)
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"level\":\"warn\"")
+				assert.Contains(t, msg, "\"near_deadline\":true")
+			},
+		},
+		"WithDeadlineWarning when deadline is over the threshold should not change the event": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.TODO(), time.Hour)
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"level\":\"info\"")
+				assert.NotContains(t, msg, "near_deadline")
+			},
+		},
+		"WithDeadlineWarning when context has no deadline should not change the event": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.TODO())
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"level\":\"info\"")
+				assert.NotContains(t, msg, "near_deadline")
+			},
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithDeadlineWarning(time.Second)
+			})
+			ctx, cancel := sut.ctx()
+			defer cancel()
+
+			Info(ctx).Msg("deadline log")
+
+			sut.assert(t, buff.String())
+		})
+	}
+}
+
+func TestWithDeadlineWarningOnce(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithExitFunc(func(int) {})
+		cfg.WithDeadlineWarning(time.Second)
+	})
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+
+	t.Run("WithDeadlineWarning when deadline is under the threshold should write the field once", func(t *testing.T) {
+		buff.Reset()
+		Info(ctx).Msg("deadline log")
+
+		assert.Equal(t, 1, strings.Count(buff.String(), `"near_deadline":true`))
+		assert.Contains(t, buff.String(), `"level":"warn"`)
+	})
+
+	t.Run("Fatal when deadline is under the threshold should write the field", func(t *testing.T) {
+		buff.Reset()
+		Fatal(ctx).Msg("deadline log")
+
+		assert.Contains(t, buff.String(), `"near_deadline":true`)
+		assert.Contains(t, buff.String(), `"level":"fatal"`)
+	})
+}
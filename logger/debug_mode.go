@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+type debugModeCtxKey struct{}
+
+// SetDebugMode stores into the context whether the request is being debugged, such as when it is routed through
+// a debug proxy or a profiler is attached, so the WithDebugMarker event option tags its log events
+// and their latency is not misattributed.
+//
+// Example usage:
+//
+//	ctx = logger.SetDebugMode(ctx, r.Header.Get("X-Debug-Session") != "")
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the debug mode is stored.
+//	enabled (bool): Whether the request is being debugged.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the debug mode.
+func SetDebugMode(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, debugModeCtxKey{}, enabled)
+}
+
+// WithDebugMarker returns an event option that writes the 'debug_mode' field set to true when the debug mode
+// stored in the context by SetDebugMode is enabled. Other log events are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.WithDebugMarker())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the debug mode field.
+func WithDebugMarker() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		if enabled, _ := ctx.Value(debugModeCtxKey{}).(bool); enabled {
+			return e.Bool("debug_mode", true)
+		}
+		return e
+	}
+}
@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDebugMarker(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(WithDebugMarker())
+	})
+
+	suts := map[string]struct {
+		ctx    context.Context
+		marked bool
+	}{
+		"WithDebugMarker when debug mode is enabled should write the debug mode field":      {ctx: SetDebugMode(context.TODO(), true), marked: true},
+		"WithDebugMarker when debug mode is disabled should not write the debug mode field": {ctx: SetDebugMode(context.TODO(), false)},
+		"WithDebugMarker when debug mode is not set should not write the debug mode field":  {ctx: context.TODO()},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff.Reset()
+			Info(sut.ctx).Msg("test")
+
+			if sut.marked {
+				assert.Contains(t, buff.String(), "\"debug_mode\":true")
+			} else {
+				assert.NotContains(t, buff.String(), "debug_mode")
+			}
+		})
+	}
+}
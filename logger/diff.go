@@ -0,0 +1,109 @@
+package logger
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Diff writes the before and after states of an update as the key object, with the 'before', 'after',
+// 'changed_fields' and 'changed' fields. For structs and maps, 'changed_fields' lists the top-level fields,
+// named after their JSON tags, whose values differ. Identical states are still written, with 'changed' set to false.
+// Both states are written as regular fields, so write time options, such as WithSecretScanning, apply to both sides.
+//
+// Example usage:
+//
+//	logger.Diff(logger.Info(ctx), "user", before, after).Msg("user updated")
+//	// "user":{"before":{...},"after":{...},"changed_fields":["email"],"changed":true}
+//
+// Params:
+//
+//	e (*zerolog.Event): The log event.
+//	key (string): The key of the diff object.
+//	before (any): The state before the update.
+//	after (any): The state after the update.
+//
+// Returns:
+//
+//	*zerolog.Event: The log event with the diff object.
+func Diff(e *zerolog.Event, key string, before, after any) *zerolog.Event {
+	if !e.Enabled() {
+		return e
+	}
+
+	changed, ok := changedFields(reflect.ValueOf(before), reflect.ValueOf(after))
+	unchanged := len(changed) == 0
+	if !ok {
+		unchanged = reflect.DeepEqual(before, after)
+	}
+
+	return e.Dict(key, zerolog.Dict().
+		Interface("before", before).
+		Interface("after", after).
+		Strs("changed_fields", changed).
+		Bool("changed", !unchanged))
+}
+
+// changedFields returns the names of the top-level fields that differ, and whether the states are structs or maps
+// whose fields could be compared.
+func changedFields(before, after reflect.Value) ([]string, bool) {
+	before, after = indirect(before), indirect(after)
+	if !before.IsValid() || !after.IsValid() || before.Type() != after.Type() {
+		return []string{}, false
+	}
+
+	changed := []string{}
+
+	switch before.Kind() {
+	case reflect.Struct:
+		t := before.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name, ok := fieldName(t.Field(i))
+			if ok && !reflect.DeepEqual(before.Field(i).Interface(), after.Field(i).Interface()) {
+				changed = append(changed, name)
+			}
+		}
+	case reflect.Map:
+		keys := map[string]reflect.Value{}
+		for _, k := range append(before.MapKeys(), after.MapKeys()...) {
+			keys[fmt.Sprint(k.Interface())] = k
+		}
+		for name, k := range keys {
+			b, a := before.MapIndex(k), after.MapIndex(k)
+			if !b.IsValid() || !a.IsValid() || !reflect.DeepEqual(b.Interface(), a.Interface()) {
+				changed = append(changed, name)
+			}
+		}
+		sort.Strings(changed)
+	default:
+		return changed, false
+	}
+
+	return changed, true
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		v = v.Elem()
+	}
+	return v
+}
+
+// fieldName returns the JSON name of an exported struct field, or false if the field is not marshaled.
+func fieldName(f reflect.StructField) (string, bool) {
+	if !f.IsExported() {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return f.Name, true
+	}
+	return name, true
+}
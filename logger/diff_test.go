@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type diffUser struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	token string
+}
+
+func TestDiff(t *testing.T) {
+	suts := map[string]struct {
+		before any
+		after  any
+		assert func(t *testing.T, msg string)
+	}{
+		"Diff when struct field changed should list the changed field": {
+			before: diffUser{Name: "Ann", Email: "ann@a.com", token: "a"},
+			after:  &diffUser{Name: "Ann", Email: "ann@b.com", token: "b"},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"before\":{\"name\":\"Ann\",\"email\":\"ann@a.com\"}")
+				assert.Contains(t, msg, "\"after\":{\"name\":\"Ann\",\"email\":\"ann@b.com\"}")
+				assert.Contains(t, msg, "\"changed_fields\":[\"email\"],\"changed\":true")
+			},
+		},
+		"Diff when struct is unchanged should flag it as unchanged": {
+			before: diffUser{Name: "Ann", Email: "ann@a.com"},
+			after:  diffUser{Name: "Ann", Email: "ann@a.com"},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"before\":{\"name\":\"Ann\",\"email\":\"ann@a.com\"}")
+				assert.Contains(t, msg, "\"changed_fields\":[],\"changed\":false")
+			},
+		},
+		"Diff when map keys changed should list the changed keys": {
+			before: map[string]int{"a": 1, "b": 2},
+			after:  map[string]int{"a": 1, "b": 3, "c": 4},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"changed_fields\":[\"b\",\"c\"],\"changed\":true")
+			},
+		},
+		"Diff when scalar changed should flag it as changed": {
+			before: 1,
+			after:  2,
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"changed_fields\":[],\"changed\":true")
+			},
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+			})
+
+			Diff(Info(context.TODO()), "user", sut.before, sut.after).Msg("updated")
+
+			sut.assert(t, buff.String())
+		})
+	}
+}
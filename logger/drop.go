@@ -0,0 +1,102 @@
+package logger
+
+import (
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+var dropped atomic.Uint64
+
+// DropReason represents the mechanism that discarded a log event.
+type DropReason int
+
+const (
+	DropSampled     DropReason = iota // Discarded by a sampler, such as WithBurstSamplerForLevel or WithTailSampling.
+	DropThrottled                     // Discarded by WithThrottle.
+	DropFiltered                      // Below the current level.
+	DropBreaker                       // Discarded while the breaker of WithWriterCircuitBreaker is open.
+	DropBufferFull                    // Discarded while the buffer of WithBufferedWriter is full.
+	DropWriteFailed                   // Not written by the output destination, such as a failed batch flush.
+	DropErrorStorm                    // Collapsed into the summary of WithErrorStormProtection.
+)
+
+// String returns the name of the drop reason.
+func (r DropReason) String() string {
+	switch r {
+	case DropSampled:
+		return "sampled"
+	case DropThrottled:
+		return "throttled"
+	case DropFiltered:
+		return "filtered"
+	case DropBreaker:
+		return "breaker"
+	case DropBufferFull:
+		return "buffer_full"
+	case DropWriteFailed:
+		return "write_failed"
+	case DropErrorStorm:
+		return "error_storm"
+	default:
+		return "unknown"
+	}
+}
+
+// DropCallback represents a function invoked with the reason, level and message of each discarded log event.
+type DropCallback func(reason DropReason, level zerolog.Level, message string)
+
+// WithDropCallback registers a callback invoked whenever a log event is discarded by a built-in mechanism,
+// such as sampling, throttling or a full buffer, so the drops can be observed by metrics.
+// Events below the current level are reported as DropFiltered, without being counted by Dropped.
+// The message is empty for the events discarded before being written, such as the sampled and filtered ones.
+// Callbacks run synchronously, often on the logging goroutine or while a writer holds a lock,
+// so they must be fast and must not block nor log.
+//
+// Example usage:
+//
+//	cfg.WithDropCallback(func(reason logger.DropReason, level zerolog.Level, message string) {
+//	    droppedLogs.WithLabelValues(reason.String(), level.String()).Inc()
+//	})
+//
+// Params:
+//
+//	fn (DropCallback): The callback invoked for each discarded log event.
+func (cfg *LoggerConfig) WithDropCallback(fn DropCallback) {
+	cfg.dropCallbacks = append(cfg.dropCallbacks, fn)
+}
+
+// Dropped returns the number of log events discarded by the built-in mechanisms,
+// such as throttling, since the program started or the last Reset.
+//
+// Example usage:
+//
+//	droppedLogs.Set(float64(logger.Dropped())) // Exposes the drops as a metric.
+//
+// Returns:
+//
+//	uint64: The number of discarded log events.
+func Dropped() uint64 {
+	return dropped.Load()
+}
+
+// drop counts the discarded log event and reports it to the drop callbacks.
+func drop(reason DropReason, level zerolog.Level, msg string) {
+	dropped.Add(1)
+	notifyDrop(reason, level, msg)
+}
+
+// dropRendered is drop for JSON rendered log events, whose message is only decoded when there are drop callbacks.
+func dropRendered(reason DropReason, level zerolog.Level, p []byte) {
+	msg := ""
+	if len(config().dropCallbacks) > 0 {
+		msg = message(p)
+	}
+	drop(reason, level, msg)
+}
+
+func notifyDrop(reason DropReason, level zerolog.Level, msg string) {
+	for _, fn := range config().dropCallbacks {
+		fn(reason, level, msg)
+	}
+}
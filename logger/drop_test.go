@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+type droppedEvent struct {
+	reason DropReason
+	level  zerolog.Level
+	msg    string
+}
+
+func TestWithDropCallback(t *testing.T) {
+	var drops []droppedEvent
+	configure := func(opts ...LoggerOption) {
+		drops = nil
+		Configure(append([]LoggerOption{func(cfg *LoggerConfig) {
+			cfg.WithWriter(&bytes.Buffer{})
+			cfg.WithDropCallback(func(reason DropReason, level zerolog.Level, msg string) {
+				drops = append(drops, droppedEvent{reason: reason, level: level, msg: msg})
+			})
+		}}, opts...)...)
+	}
+
+	t.Run("WithDropCallback when event is sampled should report the sampled reason", func(t *testing.T) {
+		configure(func(cfg *LoggerConfig) {
+			cfg.WithBurstSamplerForLevel(zerolog.InfoLevel, 1, time.Hour, nil)
+		})
+		before := Dropped()
+
+		Info(context.TODO()).Msg("written")
+		Info(context.TODO()).Msg("sampled")
+
+		assert.Equal(t, []droppedEvent{{reason: DropSampled, level: zerolog.InfoLevel}}, drops)
+		assert.Equal(t, before+1, Dropped())
+	})
+
+	t.Run("WithDropCallback when event is below the level should report the filtered reason", func(t *testing.T) {
+		configure(func(cfg *LoggerConfig) {
+			cfg.WithLevel(zerolog.InfoLevel)
+		})
+		before := Dropped()
+
+		Debug(context.TODO()).Msg("filtered")
+
+		assert.Equal(t, []droppedEvent{{reason: DropFiltered, level: zerolog.DebugLevel}}, drops)
+		assert.Equal(t, before, Dropped())
+	})
+
+	t.Run("WithDropCallback when rendered event is dropped should report its message", func(t *testing.T) {
+		configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(&failingWriter{fail: true})
+			cfg.WithWriterCircuitBreaker(1, time.Hour)
+		})
+		zerolog.ErrorHandler = func(err error) {}
+		t.Cleanup(func() {
+			zerolog.ErrorHandler = nil
+		})
+
+		Info(context.TODO()).Msg("failed")
+		Warn(context.TODO()).Msg("breaker")
+
+		assert.Equal(t, []droppedEvent{{reason: DropBreaker, level: zerolog.WarnLevel, msg: "breaker"}}, drops)
+	})
+}
+
+func TestDropReason(t *testing.T) {
+	t.Run("String when reason is known should return its name", func(t *testing.T) {
+		assert.Equal(t, "buffer_full", DropBufferFull.String())
+		assert.Equal(t, "error_storm", DropErrorStorm.String())
+	})
+}
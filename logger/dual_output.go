@@ -0,0 +1,114 @@
+package logger
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// WithDualOutput sets up the common "pretty for humans, JSON for machines" output: log events are written to os.Stdout
+// in FormatConsole and, simultaneously, to the given file as newline-delimited JSON, or with the encoder set by WithEncoder.
+// The file takes the place of the writer set by WithWriter, so the compressed file set by WithGzipFile or the batching writer
+// set by WithBatchWriter receive the JSON log events instead, as they would replace the file. The configured format is ignored,
+// since os.Stdout always receives FormatConsole, while the format of a context, set by the WithFormat function, applies to the file.
+// Both outputs receive every log event with its level, so level-aware writer options keep working.
+// The file and its directory are created, or appended to, on the first write, and the file is closed on Shutdown.
+//
+// Example usage:
+//
+//	cfg.WithDualOutput("/var/log/payment-service/app.jsonl")
+//
+// Params:
+//
+//	filePath (string): The path of the file receiving the JSON log events.
+func (cfg *LoggerConfig) WithDualOutput(filePath string) {
+	cfg.dualOutput = filePath
+}
+
+// dualOutputWriter returns the writer rendering log events in FormatConsole to os.Stdout and as JSON to the output destination,
+// which holds the file, or with the format of the context set by WithFormat.
+func (cfg *LoggerConfig) dualOutputWriter(format Format) *dualWriter {
+	if format == formatDefault && cfg.customEncoder == nil {
+		format = FormatJSON
+	}
+
+	return &dualWriter{console: cfg.encoder(os.Stdout, FormatConsole), file: cfg.encoder(cfg.base, format)}
+}
+
+// dualWriter writes each log event to both the console encoder and the file, preserving its level.
+type dualWriter struct {
+	console io.Writer
+	file    io.Writer
+}
+
+func (w *dualWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *dualWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	_, fErr := writeLevel(w.file, level, p)
+	_, cErr := writeLevel(w.console, level, p)
+
+	if err := errors.Join(fErr, cErr); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *dualWriter) Sync() error {
+	return errors.Join(syncWriter(w.file), syncWriter(w.console))
+}
+
+// fileWriter appends to a file, created along with its directory on the first write.
+type fileWriter struct {
+	path string
+	mu   sync.Mutex
+	f    *os.File
+}
+
+func (w *fileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.f == nil {
+		if err := os.MkdirAll(filepath.Dir(w.path), 0o755); err != nil {
+			return 0, err
+		}
+		f, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return 0, err
+		}
+		w.f = f
+	}
+
+	return w.f.Write(p)
+}
+
+// Sync commits the written log events to the file.
+func (w *fileWriter) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.f == nil {
+		return nil
+	}
+	return w.f.Sync()
+}
+
+// Close closes the file. The file is reopened on the next write.
+func (w *fileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.f == nil {
+		return nil
+	}
+
+	err := w.f.Close()
+	w.f = nil
+	return err
+}
@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDualOutput(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs", "app.jsonl")
+
+	stdout, err := os.CreateTemp(dir, "stdout")
+	assert.NoError(t, err)
+	original := os.Stdout
+	os.Stdout = stdout
+	t.Cleanup(func() { os.Stdout = original })
+
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithDualOutput(path)
+	})
+
+	t.Run("WithDualOutput when event is written should write it pretty to stdout and as JSON to the file", func(t *testing.T) {
+		Info(context.TODO()).Str("order_id", "42").Msg("order created")
+		assert.NoError(t, Shutdown(context.Background()))
+
+		console, err := os.ReadFile(stdout.Name())
+		assert.NoError(t, err)
+		assert.False(t, json.Valid(console))
+		assert.Contains(t, string(console), "order created")
+		assert.Contains(t, string(console), "order_id=")
+
+		file, err := os.ReadFile(path)
+		assert.NoError(t, err)
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(file, &fields))
+		assert.Equal(t, "info", fields["level"])
+		assert.Equal(t, "order created", fields["message"])
+		assert.Equal(t, "42", fields["order_id"])
+	})
+
+	t.Run("WithDualOutput when encoder and batching writer are set should write through them", func(t *testing.T) {
+		var flushed []string
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithDualOutput(path)
+			cfg.WithEncoder(LogfmtEncoder{})
+			cfg.WithBatchWriter(func(ctx context.Context, batch [][]byte) error {
+				for _, p := range batch {
+					flushed = append(flushed, string(p))
+				}
+				return nil
+			}, 10, time.Hour)
+		})
+
+		Info(context.TODO()).Msg("batched")
+		assert.NoError(t, Shutdown(context.Background()))
+
+		assert.Len(t, flushed, 1)
+		assert.Contains(t, flushed[0], `message=batched`)
+		console, err := os.ReadFile(stdout.Name())
+		assert.NoError(t, err)
+		assert.Contains(t, string(console), "batched")
+	})
+}
@@ -0,0 +1,26 @@
+package logger
+
+import "github.com/rs/zerolog"
+
+// rfc3339Millis is the RFC3339 layout with millisecond precision, matching the precision of epoch milliseconds.
+const rfc3339Millis = "2006-01-02T15:04:05.000Z07:00"
+
+// WithDualTimestamps writes the time of every log event twice, as epoch milliseconds under epochField for programmatic queries
+// and as an RFC3339 string with millisecond precision under humanField for manual reading. Both fields are computed from
+// the same instant, generated by the configured timestamp function, so they always agree. The 'time' field is not changed.
+//
+// Example usage:
+//
+//	cfg.WithDualTimestamps("ts", "ts_human")
+//	logger.Info(ctx).Msg("started") // "ts":1714564800123,"ts_human":"2024-05-01T12:00:00.123Z"
+//
+// Params:
+//
+//	epochField (string): The name of the field holding the epoch milliseconds.
+//	humanField (string): The name of the field holding the RFC3339 string.
+func (cfg *LoggerConfig) WithDualTimestamps(epochField, humanField string) {
+	cfg.hooks = append(cfg.hooks, zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+		now := cfg.timestampFunc()
+		e.Int64(epochField, now.UnixMilli()).Str(humanField, now.Format(rfc3339Millis))
+	}))
+}
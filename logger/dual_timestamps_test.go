@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDualTimestamps(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithUTC()
+		cfg.WithDualTimestamps("ts", "ts_human")
+	})
+
+	t.Run("WithDualTimestamps when event is written should write both fields from the same instant", func(t *testing.T) {
+		before := time.Now().UnixMilli()
+		Info(context.TODO()).Msg("test")
+		after := time.Now().UnixMilli()
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+
+		epoch := int64(fields["ts"].(float64))
+		human, err := time.Parse(time.RFC3339, fields["ts_human"].(string))
+		assert.NoError(t, err)
+		assert.Equal(t, epoch, human.UnixMilli())
+		assert.GreaterOrEqual(t, epoch, before)
+		assert.LessOrEqual(t, epoch, after)
+		assert.Equal(t, time.UTC, human.Location())
+	})
+}
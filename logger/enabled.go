@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+// Enabled reports whether a log event at the given level, created with the context, would be written, so callers can skip
+// building expensive payloads. It accounts for the runtime level set by SetLevel, the level override of the context baggage
+// set by WithBaggageLevelOverride, the levels of the context logger and of zerolog, and the level changes made by options
+// such as WithDeadlineWarning. Samplers are not consulted, so it only approximates sampled levels: an enabled level may still
+// have some of its log events discarded.
+//
+// Example usage:
+//
+//	if logger.Enabled(ctx, zerolog.DebugLevel) {
+//	    logger.Debug(ctx).Interface("payload", buildDump()).Msg("request dump")
+//	}
+//
+// Params:
+//
+//	ctx (context.Context): The context the log event would be created with.
+//	level (zerolog.Level): The level of the log event.
+//
+// Returns:
+//
+//	bool: Whether the log event would be written.
+func Enabled(ctx context.Context, level zerolog.Level) bool {
+	cfg := config()
+	level, _ = resolveLevel(ctx, level)
+
+	l, ok := ctx.Value(loggerCtxKey{}).(zerolog.Logger)
+	if !ok {
+		l = cfg.root
+	}
+	if level == zerolog.Disabled || level < l.GetLevel() || level < zerolog.GlobalLevel() {
+		return false
+	}
+
+	threshold := GetLevel()
+	if cfg.baggageLevel != "" {
+		if override, ok := baggageLevel(ctx, cfg.baggageLevel); ok && override < threshold {
+			threshold = override
+		}
+	}
+
+	return level >= threshold
+}
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+func TestEnabled(t *testing.T) {
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(io.Discard)
+		cfg.WithLevel(zerolog.InfoLevel)
+		cfg.WithBaggageLevelOverride("log.level")
+	})
+
+	member, err := baggage.NewMember("log.level", "debug")
+	assert.NoError(t, err)
+	b, err := baggage.New(member)
+	assert.NoError(t, err)
+	debugCtx := baggage.ContextWithBaggage(context.TODO(), b)
+
+	suts := map[string]struct {
+		ctx     context.Context
+		level   zerolog.Level
+		enabled bool
+	}{
+		"Enabled when level is above the configured level should report enabled": {
+			ctx: context.TODO(), level: zerolog.WarnLevel, enabled: true,
+		},
+		"Enabled when level is below the configured level should report disabled": {
+			ctx: context.TODO(), level: zerolog.DebugLevel,
+		},
+		"Enabled when context baggage lowers the level should report enabled": {
+			ctx: debugCtx, level: zerolog.DebugLevel, enabled: true,
+		},
+		"Enabled when level is below the context baggage level should report disabled": {
+			ctx: debugCtx, level: zerolog.TraceLevel,
+		},
+		"Enabled when context logger level is higher should report disabled": {
+			ctx: withLogger(context.TODO(), FromContext(context.TODO()).Level(zerolog.ErrorLevel)), level: zerolog.WarnLevel,
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, sut.enabled, Enabled(sut.ctx, sut.level))
+		})
+	}
+
+	t.Run("Enabled when level is changed at runtime should report the new level", func(t *testing.T) {
+		SetLevel(zerolog.DebugLevel)
+		defer SetLevel(zerolog.InfoLevel)
+
+		assert.True(t, Enabled(context.TODO(), zerolog.DebugLevel))
+	})
+}
@@ -0,0 +1,142 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Encoder renders log events in a custom output format, replacing the JSON rendered by zerolog.
+type Encoder interface {
+	// Encode renders the log event, including the trailing newline when the format is line-delimited.
+	Encode(record LogRecord) ([]byte, error)
+}
+
+// LogRecord is a log event decoded into its top-level fields, preserving their order.
+// Values are decoded from JSON, so numbers are json.Number, objects are map[string]any and arrays are []any.
+type LogRecord struct {
+	Level  zerolog.Level // Level of the log event, or zerolog.NoLevel when unknown.
+	Fields []LogField    // Fields of the log event, including level, time and message.
+}
+
+// LogField is a top-level field of a log event.
+type LogField struct {
+	Key   string
+	Value any
+}
+
+// WithEncoder sets the encoder rendering log events, swapping the output format while keeping the rest of the pipeline,
+// such as the record options, hooks and writers. The encoder receives the log events once every write time option is applied,
+// and replaces the format set by WithFormat. The default output is the JSON rendered by zerolog.
+//
+// Example usage:
+//
+//	cfg.WithEncoder(logger.LogfmtEncoder{}) // level=info time=2024-05-01T12:00:00Z message="order created"
+//
+// Params:
+//
+//	e (Encoder): The encoder rendering log events.
+func (cfg *LoggerConfig) WithEncoder(e Encoder) {
+	cfg.customEncoder = e
+}
+
+// encoderWriter renders each JSON rendered log event with the encoder before writing it.
+// Events that are not JSON objects are written unchanged.
+type encoderWriter struct {
+	w   io.Writer
+	enc Encoder
+}
+
+func (w *encoderWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *encoderWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	r, err := decodeRecord(level, p)
+	if err != nil {
+		return writeLevel(w.w, level, p)
+	}
+	defer releaseRecord(r)
+
+	lr := LogRecord{Level: level, Fields: make([]LogField, 0, len(r.fields))}
+	for _, f := range r.fields {
+		value, err := decodeValue(f.value)
+		if err != nil {
+			return 0, err
+		}
+		lr.Fields = append(lr.Fields, LogField{Key: f.key, Value: value})
+	}
+
+	encoded, err := w.enc.Encode(lr)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := writeLevel(w.w, level, encoded); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *encoderWriter) Sync() error {
+	return syncWriter(w.w)
+}
+
+// LogfmtEncoder renders log events as logfmt lines, such as `level=info message="order created" order_id=42`.
+// Objects and arrays are rendered as quoted JSON.
+type LogfmtEncoder struct{}
+
+// Encode renders the log event as a logfmt line.
+func (LogfmtEncoder) Encode(record LogRecord) ([]byte, error) {
+	buf := &bytes.Buffer{}
+
+	for i, f := range record.Fields {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+
+		value, err := logfmtValue(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(f.Key)
+		buf.WriteByte('=')
+		buf.WriteString(value)
+	}
+
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func logfmtValue(value any) (string, error) {
+	var s string
+
+	switch v := value.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		s = v
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case map[string]any, []any:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		s = string(raw)
+	default:
+		s = fmt.Sprint(v)
+	}
+
+	if s == "" || strings.ContainsFunc(s, func(r rune) bool { return r <= ' ' || r == '=' || r == '"' || r == '\\' }) {
+		return strconv.Quote(s), nil
+	}
+	return s, nil
+}
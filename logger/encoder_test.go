@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithEncoder(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEncoder(LogfmtEncoder{})
+		cfg.WithContextFields(func(c zerolog.Context) zerolog.Context {
+			return c.Str("service", "checkout")
+		})
+	})
+
+	t.Run("WithEncoder when using logfmt should write key=value pairs", func(t *testing.T) {
+		buff.Reset()
+		Info(context.TODO()).Int("order_id", 42).Bool("paid", true).Msg("order created")
+
+		line := buff.String()
+		assert.Contains(t, line, "level=info ")
+		assert.Contains(t, line, " service=checkout ")
+		assert.Contains(t, line, " order_id=42 ")
+		assert.Contains(t, line, " paid=true ")
+		assert.Contains(t, line, " message=\"order created\"\n")
+		assert.NotContains(t, line, "{")
+	})
+
+	t.Run("WithEncoder when using logfmt should quote objects and arrays as JSON", func(t *testing.T) {
+		buff.Reset()
+		Info(context.TODO()).Strs("tags", []string{"a", "b"}).Msg("test")
+
+		assert.Contains(t, buff.String(), ` tags="[\"a\",\"b\"]" `)
+	})
+}
+
+func TestLogfmtEncoder(t *testing.T) {
+	t.Run("Encode when values need quoting should quote them", func(t *testing.T) {
+		encoded, err := LogfmtEncoder{}.Encode(LogRecord{Fields: []LogField{
+			{Key: "empty", Value: ""},
+			{Key: "equals", Value: "a=b"},
+			{Key: "null", Value: nil},
+			{Key: "plain", Value: "value"},
+		}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "empty=\"\" equals=\"a=b\" null=null plain=value\n", string(encoded))
+	})
+}
@@ -0,0 +1,140 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Entry accumulates the fields of a log event whose level is only decided once the fields are known.
+// Fields are applied in order when the entry is logged, and an entry that is never logged is simply discarded.
+// An Entry is not safe for concurrent use.
+type Entry struct {
+	ctx    context.Context
+	err    error
+	fields []func(e *zerolog.Event) *zerolog.Event
+}
+
+// NewEntry starts a new entry, logged through the same pipeline as the level functions,
+// such as Info, when one of its terminal methods is called.
+//
+// Example usage:
+//
+//	entry := logger.NewEntry(ctx).Str("order_id", id)
+//	if err := charge(ctx); err != nil {
+//		entry.Err(err).Error().Msg("charge failed")
+//		return
+//	}
+//	entry.Int("attempts", attempts).Info().Msg("charged")
+//
+// Params:
+//
+//	ctx (context.Context): The context from which to extract tracing information.
+//
+// Returns:
+//
+//	*Entry: The entry accumulating the fields.
+func NewEntry(ctx context.Context) *Entry {
+	return &Entry{ctx: ctx}
+}
+
+func (en *Entry) with(fn func(e *zerolog.Event) *zerolog.Event) *Entry {
+	en.fields = append(en.fields, fn)
+	return en
+}
+
+// Str adds the field key with val as a string.
+func (en *Entry) Str(key, val string) *Entry {
+	return en.with(func(e *zerolog.Event) *zerolog.Event { return e.Str(key, val) })
+}
+
+// Int adds the field key with val as an int.
+func (en *Entry) Int(key string, val int) *Entry {
+	return en.with(func(e *zerolog.Event) *zerolog.Event { return e.Int(key, val) })
+}
+
+// Int64 adds the field key with val as an int64.
+func (en *Entry) Int64(key string, val int64) *Entry {
+	return en.with(func(e *zerolog.Event) *zerolog.Event { return e.Int64(key, val) })
+}
+
+// Float64 adds the field key with val as a float64.
+func (en *Entry) Float64(key string, val float64) *Entry {
+	return en.with(func(e *zerolog.Event) *zerolog.Event { return e.Float64(key, val) })
+}
+
+// Bool adds the field key with val as a bool.
+func (en *Entry) Bool(key string, val bool) *Entry {
+	return en.with(func(e *zerolog.Event) *zerolog.Event { return e.Bool(key, val) })
+}
+
+// Dur adds the field key with val as a time.Duration.
+func (en *Entry) Dur(key string, val time.Duration) *Entry {
+	return en.with(func(e *zerolog.Event) *zerolog.Event { return e.Dur(key, val) })
+}
+
+// Time adds the field key with val as a time.Time.
+func (en *Entry) Time(key string, val time.Time) *Entry {
+	return en.with(func(e *zerolog.Event) *zerolog.Event { return e.Time(key, val) })
+}
+
+// Any adds the field key with val marshaled by zerolog.
+func (en *Entry) Any(key string, val any) *Entry {
+	return en.with(func(e *zerolog.Event) *zerolog.Event { return e.Interface(key, val) })
+}
+
+// Err attaches the error to the entry, applying the error event modifiers, such as WithErrorChain, when logged.
+// Attaching a nil error does not change the entry.
+func (en *Entry) Err(err error) *Entry {
+	if err != nil {
+		en.err = err
+	}
+	return en
+}
+
+// Log starts a new logging event at the given level with the accumulated fields.
+// It returns a *zerolog.Event that is not sent until the Msg method is called.
+//
+// Example usage:
+//
+//	logger.NewEntry(ctx).Str("order_id", id).Log(level).Msg("order processed")
+//
+// Params:
+//
+//	level (zerolog.Level): The level of the log event.
+//
+// Returns:
+//
+//	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
+func (en *Entry) Log(level zerolog.Level) *zerolog.Event {
+	e := event(en.ctx, errEvent(en.ctx, newEvent(en.ctx, level), en.err))
+	if !e.Enabled() {
+		return e
+	}
+
+	for _, fn := range en.fields {
+		e = fn(e)
+	}
+	return e
+}
+
+// Debug starts a new logging event at the "debug" level with the accumulated fields.
+func (en *Entry) Debug() *zerolog.Event {
+	return en.Log(zerolog.DebugLevel)
+}
+
+// Info starts a new logging event at the "info" level with the accumulated fields.
+func (en *Entry) Info() *zerolog.Event {
+	return en.Log(zerolog.InfoLevel)
+}
+
+// Warn starts a new logging event at the "warn" level with the accumulated fields.
+func (en *Entry) Warn() *zerolog.Event {
+	return en.Log(zerolog.WarnLevel)
+}
+
+// Error starts a new logging event at the "error" level with the accumulated fields.
+func (en *Entry) Error() *zerolog.Event {
+	return en.Log(zerolog.ErrorLevel)
+}
@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntry(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithLevel(zerolog.InfoLevel)
+	})
+
+	suts := map[string]struct {
+		log    func(en *Entry)
+		assert func(t *testing.T, msg string)
+	}{
+		"Log when level is chosen should write the accumulated fields at the level": {
+			log: func(en *Entry) { en.Log(zerolog.WarnLevel).Msg("built") },
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"level\":\"warn\"")
+				assert.Contains(t, msg, "\"order_id\":\"42\"")
+				assert.Contains(t, msg, "\"attempts\":3")
+				assert.Contains(t, msg, "\"message\":\"built\"")
+			},
+		},
+		"Error when error is attached should write the error": {
+			log: func(en *Entry) { en.Err(errors.New("declined")).Error().Msg("built") },
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"level\":\"error\"")
+				assert.Contains(t, msg, "\"error\":\"declined\"")
+				assert.Contains(t, msg, "\"order_id\":\"42\"")
+			},
+		},
+		"Debug when level is disabled should not write the entry": {
+			log: func(en *Entry) { en.Debug().Msg("built") },
+			assert: func(t *testing.T, msg string) {
+				assert.Empty(t, msg)
+			},
+		},
+		"NewEntry when entry is discarded should not write anything": {
+			log: func(en *Entry) {},
+			assert: func(t *testing.T, msg string) {
+				assert.Empty(t, msg)
+			},
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff.Reset()
+
+			sut.log(NewEntry(context.TODO()).Str("order_id", "42").Int("attempts", 3))
+
+			sut.assert(t, buff.String())
+		})
+	}
+}
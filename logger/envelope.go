@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// WithEnvelope wraps every log event at write time in the envelope required by central log pipelines,
+// nesting the rendered log event under the 'log' key alongside the 'meta' object, such as
+// {"meta":{"schema":"v2","source":"payments"},"log":{"level":"info","message":"order created"}}.
+// The envelope is applied after every other write-time option, once the log events are routed, such as by WithFieldRoutedWriter,
+// so routing sees the fields of the log event, and the output stays newline-delimited JSON.
+// The meta values must be marshalable to JSON, otherwise the 'meta' object is omitted.
+//
+// Example usage:
+//
+//	cfg.WithEnvelope(map[string]any{"schema_version": 2, "source": "payment-service"})
+//
+// Params:
+//
+//	meta (map[string]any): The fields of the 'meta' object.
+func (cfg *LoggerConfig) WithEnvelope(meta map[string]any) {
+	raw, err := json.Marshal(meta)
+	if err != nil {
+		raw = nil
+	}
+
+	cfg.envelope = func(r *record) {
+		log := bytes.TrimSuffix((&record{fields: r.fields}).encode(), []byte("\n"))
+
+		r.fields = r.fields[:0:0]
+		if raw != nil {
+			r.fields = append(r.fields, field{key: "meta", value: raw})
+		}
+		r.fields = append(r.fields, field{key: "log", value: log})
+	}
+}
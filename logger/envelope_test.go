@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWithEnvelope(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEnvelope(map[string]any{"schema_version": 2, "source": "payment-service"})
+		cfg.WithReplaceField(func(key string, value any) (string, any, bool) {
+			return key, value, key != "password"
+		})
+	})
+
+	t.Run("WithEnvelope when event is written should nest it under the log key", func(t *testing.T) {
+		buff.Reset()
+		Info(context.TODO()).Str("order_id", "42").Str("password", "hunter2").Msg("order created")
+		Info(context.TODO()).Msg("second")
+
+		lines := bytes.Split(bytes.TrimSuffix(buff.Bytes(), []byte("\n")), []byte("\n"))
+		assert.Len(t, lines, 2)
+
+		var envelope struct {
+			Meta map[string]any `json:"meta"`
+			Log  map[string]any `json:"log"`
+		}
+		assert.NoError(t, json.Unmarshal(lines[0], &envelope))
+		assert.Equal(t, map[string]any{"schema_version": float64(2), "source": "payment-service"}, envelope.Meta)
+		assert.Equal(t, "info", envelope.Log["level"])
+		assert.Equal(t, "order created", envelope.Log["message"])
+		assert.Equal(t, "42", envelope.Log["order_id"])
+		assert.NotContains(t, envelope.Log, "password")
+		assert.True(t, json.Valid(lines[1]))
+	})
+}
+
+func TestWithEnvelopeRouting(t *testing.T) {
+	t.Run("WithEnvelope when events are routed should route them by their fields before wrapping them", func(t *testing.T) {
+		out, audit := &bytes.Buffer{}, &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(out)
+			cfg.WithEnvelope(map[string]any{"source": "payment-service"})
+			cfg.WithExclusiveFieldRoutedWriter("audit", "true", audit)
+		})
+
+		Info(context.TODO()).Bool("audit", true).Msg("audited")
+
+		var envelope struct {
+			Log map[string]any `json:"log"`
+		}
+		assert.NoError(t, json.Unmarshal(audit.Bytes(), &envelope))
+		assert.Equal(t, "audited", envelope.Log["message"])
+		assert.Empty(t, out.String())
+	})
+
+	t.Run("WithEnvelope when events are trace routed should route them by their span", func(t *testing.T) {
+		sampled, unsampled := &bytes.Buffer{}, &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithEnvelope(map[string]any{"source": "payment-service"})
+			cfg.WithTraceRoutedWriters(sampled, unsampled)
+		})
+		ctx := trace.ContextWithSpanContext(context.TODO(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{1},
+			TraceFlags: trace.FlagsSampled,
+		}))
+
+		Info(ctx).Msg("sampled")
+
+		assert.Contains(t, sampled.String(), `"log":{`)
+		assert.NotContains(t, sampled.String(), traceSampledFieldName)
+		assert.Empty(t, unsampled.String())
+	})
+}
@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ErrGroup is an errgroup.Group logging the errors and panics of its goroutines, and a summary once Wait returns.
+type ErrGroup struct {
+	group      *errgroup.Group
+	ctx        context.Context // Context of the caller, used by the summary.
+	groupCtx   context.Context // Context of the group, used by the goroutine logs.
+	goroutines atomic.Int64
+}
+
+// WrapErrGroup returns an ErrGroup and its derived context, canceled when a goroutine returns an error or Wait returns.
+// Errors returned by the goroutines are logged with the group context through Err, including the 'goroutine' field,
+// so the first error and the cancellations it causes on the siblings can be told apart. Panics are recovered, logged
+// at the "error" level with the 'panic' field, and returned as errors so the group is canceled instead of crashing the program.
+// Wait logs a summary with the 'goroutines' field and the first error, at the "error" level when the group failed.
+//
+// Example usage:
+//
+//	g, gctx := logger.WrapErrGroup(ctx)
+//	g.Go(func() error { return fetchUsers(gctx) })
+//	g.Go(func() error { return fetchOrders(gctx) })
+//	err := g.Wait() // Logs "errgroup finished" with the 'goroutines' field and the first error.
+//
+// Params:
+//
+//	ctx (context.Context): The context from which the group context is derived.
+//
+// Returns:
+//
+//	*ErrGroup: The group running the goroutines.
+//	context.Context: The group context.
+func WrapErrGroup(ctx context.Context) (*ErrGroup, context.Context) {
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	return &ErrGroup{group: group, ctx: ctx, groupCtx: groupCtx}, groupCtx
+}
+
+// Go calls the given function in a new goroutine, logging its error or panic.
+// The first call to return a non-nil error cancels the group context, and its error is returned by Wait.
+//
+// Params:
+//
+//	fn (func() error): The function to be run.
+func (g *ErrGroup) Go(fn func() error) {
+	n := g.goroutines.Add(1)
+
+	g.group.Go(func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				Error(g.groupCtx).Int64("goroutine", n).Dict("panic", panicDict(r)).Msg("errgroup goroutine panicked")
+				err = fmt.Errorf("logger: errgroup goroutine panicked: %v", r)
+			}
+		}()
+
+		err = fn()
+		if err != nil {
+			Err(g.groupCtx, err).Int64("goroutine", n).Msg("errgroup goroutine failed")
+		}
+		return err
+	})
+}
+
+// SetLimit limits the number of active goroutines in the group to at most n. A negative value indicates no limit.
+//
+// Params:
+//
+//	n (int): The maximum number of active goroutines.
+func (g *ErrGroup) SetLimit(n int) {
+	g.group.SetLimit(n)
+}
+
+// Wait blocks until all goroutines have returned, logs the summary of the group and returns the first error, if any.
+//
+// Returns:
+//
+//	error: The first error returned by the goroutines.
+func (g *ErrGroup) Wait() error {
+	err := g.group.Wait()
+
+	Err(g.ctx, err).Int64("goroutines", g.goroutines.Load()).Msg("errgroup finished")
+
+	return err
+}
@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrapErrGroup(t *testing.T) {
+	buff := &lockedBuffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	t.Run("WrapErrGroup when a goroutine fails should log the error and a failed summary", func(t *testing.T) {
+		buff.Reset()
+		g, gctx := WrapErrGroup(context.TODO())
+
+		g.Go(func() error { return errors.New("upstream unavailable") })
+		g.Go(func() error {
+			<-gctx.Done()
+			return nil
+		})
+		err := g.Wait()
+
+		assert.EqualError(t, err, "upstream unavailable")
+		assert.ErrorIs(t, gctx.Err(), context.Canceled)
+
+		out := buff.String()
+		assert.Contains(t, out, `"level":"error","error":"upstream unavailable","goroutine":1`)
+		assert.Contains(t, out, `"message":"errgroup goroutine failed"`)
+		assert.Contains(t, out, `"level":"error","error":"upstream unavailable","goroutines":2`)
+		assert.Contains(t, out, `"message":"errgroup finished"`)
+	})
+
+	t.Run("WrapErrGroup when a goroutine panics should log the panic and return it as an error", func(t *testing.T) {
+		buff.Reset()
+		g, _ := WrapErrGroup(context.TODO())
+
+		g.Go(func() error { panic("boom") })
+		err := g.Wait()
+
+		assert.EqualError(t, err, "logger: errgroup goroutine panicked: boom")
+
+		out := buff.String()
+		assert.Contains(t, out, `"panic":{"type":"string","value":"boom"`)
+		assert.Contains(t, out, `"message":"errgroup goroutine panicked"`)
+		assert.Contains(t, out, `"error":"logger: errgroup goroutine panicked: boom","goroutines":1`)
+	})
+
+	t.Run("WrapErrGroup when all goroutines succeed should log an info summary", func(t *testing.T) {
+		buff.Reset()
+		g, _ := WrapErrGroup(context.TODO())
+
+		for range 3 {
+			g.Go(func() error { return nil })
+		}
+
+		assert.NoError(t, g.Wait())
+		assert.Equal(t, 1, strings.Count(buff.String(), "\n"))
+		assert.Contains(t, buff.String(), `"level":"info","goroutines":3`)
+	})
+}
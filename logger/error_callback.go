@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+type errCtxKey struct{}
+
+// WithErrorCallback registers a callback invoked whenever Err logs an error at the "error" level or above,
+// receiving the context, the attached error and the message, such as for counting errors or tripping a circuit breaker.
+// Errors downgraded by WithExpectedErrors do not invoke it. Callbacks run synchronously before the event is written,
+// so they must return quickly and never block indefinitely. A nil callback is ignored.
+//
+// Example usage:
+//
+//	cfg.WithErrorCallback(func(ctx context.Context, err error, msg string) {
+//	    breaker.RecordFailure()
+//	})
+//
+// Params:
+//
+//	fn (func(ctx context.Context, err error, msg string)): The callback invoked for each logged error.
+func (cfg *LoggerConfig) WithErrorCallback(fn func(ctx context.Context, err error, msg string)) {
+	if fn == nil {
+		return
+	}
+
+	if len(cfg.errCallbacks) == 0 {
+		cfg.hooks = append(cfg.hooks, zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+			if level < zerolog.ErrorLevel {
+				return
+			}
+
+			ctx := e.GetCtx()
+			if err, ok := ctx.Value(errCtxKey{}).(error); ok {
+				for _, fn := range cfg.errCallbacks {
+					fn(ctx, err, msg)
+				}
+			}
+		}))
+	}
+
+	cfg.errCallbacks = append(cfg.errCallbacks, fn)
+}
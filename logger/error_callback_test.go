@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+type errorCall struct {
+	ctx context.Context
+	err error
+	msg string
+}
+
+func TestWithErrorCallback(t *testing.T) {
+	var calls []errorCall
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(&bytes.Buffer{})
+		cfg.WithErrorCallback(nil)
+		cfg.WithErrorCallback(func(ctx context.Context, err error, msg string) {
+			calls = append(calls, errorCall{ctx: ctx, err: err, msg: msg})
+		})
+		cfg.WithExpectedErrors(zerolog.InfoLevel, context.Canceled)
+	})
+	type ctxKey struct{}
+	ctx := context.WithValue(context.TODO(), ctxKey{}, "request")
+
+	t.Run("Err when error is logged should invoke the callback", func(t *testing.T) {
+		calls = nil
+		err := errors.New("declined")
+
+		Err(ctx, err).Msg("charge failed")
+
+		assert.Len(t, calls, 1)
+		assert.Equal(t, err, calls[0].err)
+		assert.Equal(t, "charge failed", calls[0].msg)
+		assert.Equal(t, "request", calls[0].ctx.Value(ctxKey{}))
+	})
+
+	t.Run("Err when error is nil should not invoke the callback", func(t *testing.T) {
+		calls = nil
+
+		Err(ctx, nil).Msg("charged")
+
+		assert.Empty(t, calls)
+	})
+
+	t.Run("Err when error is expected should not invoke the callback", func(t *testing.T) {
+		calls = nil
+
+		Err(ctx, context.Canceled).Msg("client gone")
+
+		assert.Empty(t, calls)
+	})
+}
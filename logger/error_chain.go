@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// maxErrorChainDepth limits the unwrapping of errors, protecting against cyclic chains.
+const maxErrorChainDepth = 32
+
+// WithErrorChain makes Err write the 'error_chain' field alongside the 'error' field,
+// an array with the individual message of each error wrapped by the logged error.
+// Errors joined by errors.Join are flattened into the array.
+//
+// Example usage:
+//
+//	cfg.WithErrorChain()
+//	logger.Err(ctx, fmt.Errorf("a: %w", fmt.Errorf("b: %w", errors.New("c")))).Msg("failed")
+//	// "error":"a: b: c","error_chain":["a","b","c"]
+func (cfg *LoggerConfig) WithErrorChain() {
+	cfg.errFields = append(cfg.errFields, func(ctx context.Context, e *zerolog.Event, err error) *zerolog.Event {
+		return e.Strs("error_chain", errorChain(err, 0, []string{}))
+	})
+}
+
+func errorChain(err error, depth int, chain []string) []string {
+	if err == nil || depth >= maxErrorChainDepth {
+		return chain
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joined.Unwrap() {
+			chain = errorChain(err, depth+1, chain)
+		}
+		return chain
+	}
+
+	msg := err.Error()
+	next := errors.Unwrap(err)
+	if next != nil {
+		msg = strings.TrimSuffix(msg, ": "+next.Error())
+	}
+
+	return errorChain(next, depth+1, append(chain, msg))
+}
@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type cyclicError struct{}
+
+func (e *cyclicError) Error() string { return "cyclic" }
+
+func (e *cyclicError) Unwrap() error { return e }
+
+func TestWithErrorChain(t *testing.T) {
+	suts := map[string]struct {
+		err    error
+		assert func(t *testing.T, msg string)
+	}{
+		"Err when error is wrapped should write the error chain": {
+			err: fmt.Errorf("a: %w", fmt.Errorf("b: %w", errors.New("c"))),
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"error\":\"a: b: c\"")
+				assert.Contains(t, msg, "\"error_chain\":[\"a\",\"b\",\"c\"]")
+			},
+		},
+		"Err when errors are joined should flatten the error chain": {
+			err: fmt.Errorf("a: %w", errors.Join(errors.New("b"), fmt.Errorf("c: %w", errors.New("d")))),
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"error_chain\":[\"a\",\"b\",\"c\",\"d\"]")
+			},
+		},
+		"Err when error chain is cyclic should stop at the depth limit": {
+			err: &cyclicError{},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"error_chain\":[\"cyclic\"")
+			},
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithErrorChain()
+			})
+
+			Err(context.TODO(), sut.err).Msg("chain log")
+
+			sut.assert(t, buff.String())
+		})
+	}
+
+	t.Run("Err when error is nil should not write the error chain", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithErrorChain()
+		})
+
+		Err(context.TODO(), nil).Msg("chain log")
+
+		assert.NotContains(t, buff.String(), "error_chain")
+	})
+}
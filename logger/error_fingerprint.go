@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+// WithErrorFingerprint makes Err write the 'error_fingerprint' field alongside the 'error' field,
+// a stable hash of the type of the innermost wrapped error and the frame that logged it.
+// The error message is not part of the fingerprint, so variable data such as IDs does not change it,
+// and every occurrence of an error logged at the same site shares the same fingerprint.
+//
+// Example usage:
+//
+//	cfg.WithErrorFingerprint()
+//	logger.Err(ctx, fmt.Errorf("order %s: %w", id, err)).Msg("failed") // "error_fingerprint":"9f86d081884c7d65"
+func (cfg *LoggerConfig) WithErrorFingerprint() {
+	cfg.errFields = append(cfg.errFields, func(ctx context.Context, e *zerolog.Event, err error) *zerolog.Event {
+		return e.Str("error_fingerprint", errorFingerprint(err))
+	})
+}
+
+func errorFingerprint(err error) string {
+	root := err
+	for depth := 0; depth < maxErrorChainDepth; depth++ {
+		next := errors.Unwrap(root)
+		if next == nil {
+			break
+		}
+		root = next
+	}
+
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%T", root)
+
+	// The frame that logged the error is the first one outside this package, since errors are logged through
+	// several of its functions, such as Err, Entry methods or Retry.
+	if frame, ok := callerFrame(); ok {
+		fmt.Fprintf(h, "|%s|%s:%d", frame.Function, frame.File, frame.Line)
+	}
+
+	return strconv.FormatUint(h.Sum64(), 16)
+}
@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errFingerprint = errors.New("not found")
+
+func TestWithErrorFingerprint(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithErrorFingerprint()
+	})
+
+	fingerprint := func() string {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields["error_fingerprint"].(string)
+	}
+
+	t.Run("Err when errors are logged at the same site should share the fingerprint", func(t *testing.T) {
+		fingerprints := []string{}
+		for _, id := range []int{1, 2} {
+			Err(context.TODO(), fmt.Errorf("order %d: %w", id, errFingerprint)).Msg("failed")
+			fingerprints = append(fingerprints, fingerprint())
+		}
+
+		assert.NotEmpty(t, fingerprints[0])
+		assert.Equal(t, fingerprints[0], fingerprints[1])
+	})
+
+	t.Run("Err when errors are logged at different sites should not share the fingerprint", func(t *testing.T) {
+		Err(context.TODO(), errFingerprint).Msg("failed")
+		first := fingerprint()
+		Err(context.TODO(), errFingerprint).Msg("failed")
+		second := fingerprint()
+
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("Entry when errors are logged at different sites should not share the fingerprint", func(t *testing.T) {
+		NewEntry(context.TODO()).Err(errFingerprint).Error().Msg("failed")
+		first := fingerprint()
+		NewEntry(context.TODO()).Err(errFingerprint).Error().Msg("failed")
+		second := fingerprint()
+
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("Retry when errors are logged at different sites should not share the fingerprint", func(t *testing.T) {
+		Retry(context.TODO(), 1, 3, 0, errFingerprint).Msg("failed")
+		first := fingerprint()
+		Retry(context.TODO(), 1, 3, 0, errFingerprint).Msg("failed")
+		second := fingerprint()
+
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("Err when errors have different types should not share the fingerprint", func(t *testing.T) {
+		fingerprints := []string{}
+		for _, err := range []error{errFingerprint, &cyclicError{}} {
+			Err(context.TODO(), err).Msg("failed")
+			fingerprints = append(fingerprints, fingerprint())
+		}
+
+		assert.NotEqual(t, fingerprints[0], fingerprints[1])
+	})
+}
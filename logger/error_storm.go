@@ -0,0 +1,143 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// WithErrorStormProtection collapses storms of identical error events, such as the ones caused by a downstream outage,
+// protecting the alerting pipeline from floods. Events at the "error" level are identified by their 'error_fingerprint' field,
+// written by WithErrorFingerprint, or by their message and 'error' field otherwise. The first threshold events of a window
+// are written immediately, and the following ones are discarded, counted by Dropped, and reported when the window closes
+// by a summary event with the message, the error and the 'suppressed_count' field. Fatal and panic events are never collapsed.
+//
+// Example usage:
+//
+//	cfg.WithErrorStormProtection(10, time.Minute) // Writes the first 10 identical errors of each minute, then a summary.
+//
+// Params:
+//
+//	threshold (int): The number of identical error events written immediately within a window.
+//	window (time.Duration): The duration of a storm, starting at its first event.
+func (cfg *LoggerConfig) WithErrorStormProtection(threshold int, window time.Duration) {
+	cfg.writerOptions = append(cfg.writerOptions, func(w zerolog.LevelWriter) zerolog.LevelWriter {
+		return &errorStormWriter{
+			w:         w,
+			threshold: threshold,
+			window:    window,
+			storms:    map[string]*errorStorm{},
+		}
+	})
+}
+
+type errorStorm struct {
+	count       int
+	suppressed  int
+	msg         string
+	err         json.RawMessage
+	fingerprint json.RawMessage
+	timer       *time.Timer
+}
+
+type errorStormWriter struct {
+	w         zerolog.LevelWriter
+	threshold int
+	window    time.Duration
+	mu        sync.Mutex
+	storms    map[string]*errorStorm
+}
+
+func (w *errorStormWriter) Write(p []byte) (int, error) {
+	return w.w.Write(p)
+}
+
+func (w *errorStormWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	if level != zerolog.ErrorLevel {
+		return w.w.WriteLevel(level, p)
+	}
+
+	fields, err := decodeFields(p)
+	if err != nil {
+		return w.w.WriteLevel(level, p)
+	}
+	defer releaseFields(fields)
+
+	var msg string
+	_ = json.Unmarshal(fields[zerolog.MessageFieldName], &msg)
+
+	fingerprint := fields["error_fingerprint"]
+	key := string(fingerprint)
+	if key == "" {
+		key = msg + "\x00" + string(fields[zerolog.ErrorFieldName])
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	s, ok := w.storms[key]
+	if !ok {
+		s = &errorStorm{
+			msg:         msg,
+			err:         bytes.Clone(fields[zerolog.ErrorFieldName]),
+			fingerprint: bytes.Clone(fingerprint),
+		}
+		s.timer = time.AfterFunc(w.window, func() { w.close(key) })
+		w.storms[key] = s
+	}
+
+	s.count++
+	if s.count <= w.threshold {
+		return w.w.WriteLevel(level, p)
+	}
+
+	s.suppressed++
+	drop(DropErrorStorm, level, msg)
+
+	return len(p), nil
+}
+
+// Sync closes every open window, writing the summaries, and flushes the underlying writer.
+func (w *errorStormWriter) Sync() error {
+	w.mu.Lock()
+	for key, s := range w.storms {
+		s.timer.Stop()
+		w.flushStorm(key, s)
+	}
+	w.mu.Unlock()
+
+	return syncWriter(w.w)
+}
+
+func (w *errorStormWriter) close(key string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if s, ok := w.storms[key]; ok {
+		w.flushStorm(key, s)
+	}
+}
+
+func (w *errorStormWriter) flushStorm(key string, s *errorStorm) {
+	delete(w.storms, key)
+
+	if s.suppressed == 0 {
+		return
+	}
+
+	summary := &bytes.Buffer{}
+	l := zerolog.New(summary)
+	e := l.Error().Timestamp()
+	if len(s.err) > 0 {
+		e = e.RawJSON(zerolog.ErrorFieldName, s.err)
+	}
+	if len(s.fingerprint) > 0 {
+		e = e.RawJSON("error_fingerprint", s.fingerprint)
+	}
+	e.Int("suppressed_count", s.suppressed).Msg(s.msg)
+
+	_, _ = w.w.WriteLevel(zerolog.ErrorLevel, summary.Bytes())
+}
@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithErrorStormProtection(t *testing.T) {
+	buff := &lockedBuffer{}
+	var reasons []DropReason
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithErrorStormProtection(2, 50*time.Millisecond)
+		cfg.WithDropCallback(func(reason DropReason, level zerolog.Level, message string) {
+			reasons = append(reasons, reason)
+		})
+	})
+
+	t.Run("WithErrorStormProtection when errors storm should write the first ones and a summary", func(t *testing.T) {
+		buff.Reset()
+		before := Dropped()
+
+		for range 10 {
+			Err(context.TODO(), errors.New("connection refused")).Msg("payment failed")
+		}
+
+		assert.Equal(t, 2, strings.Count(buff.String(), "\n"))
+		assert.Equal(t, before+8, Dropped())
+		assert.Len(t, reasons, 8)
+		assert.Subset(t, []DropReason{DropErrorStorm}, reasons)
+
+		assert.Eventually(t, func() bool { return strings.Count(buff.String(), "\n") == 3 }, time.Second, time.Millisecond)
+		summary := buff.String()[strings.LastIndex(strings.TrimSuffix(buff.String(), "\n"), "\n")+1:]
+		assert.Contains(t, summary, `"level":"error"`)
+		assert.Contains(t, summary, `"error":"connection refused"`)
+		assert.Contains(t, summary, `"suppressed_count":8`)
+		assert.Contains(t, summary, `"message":"payment failed"`)
+	})
+
+	t.Run("WithErrorStormProtection when errors differ should not collapse them", func(t *testing.T) {
+		buff.Reset()
+
+		for i := range 3 {
+			Err(context.TODO(), errors.New("connection refused")).Msg("payment failed")
+			Err(context.TODO(), errors.New("timeout")).Msg("payment failed")
+			Warn(context.TODO()).Int("i", i).Msg("payment failed")
+		}
+		assert.NoError(t, Sync())
+
+		out := buff.String()
+		assert.Equal(t, 3, strings.Count(out, `"level":"warn"`))
+		assert.Equal(t, 2, strings.Count(out, `"suppressed_count":1`))
+		assert.Equal(t, 9, strings.Count(out, "\n"))
+	})
+}
@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+// eventHashSize is the number of bytes of the SHA-256 digest kept in the event hash.
+const eventHashSize = 16
+
+// WithEventHash writes, at write time, a hash of the level, the message and the given top-level fields of each log event
+// as the field fieldName, so identical events logged by different replicas can be deduplicated downstream.
+// The hash is the hex encoded SHA-256 digest, truncated to 16 bytes, of a canonical encoding of those fields,
+// where object keys are sorted and numbers are kept as written, making it independent of the field order
+// and of the process. Missing fields are left out of the hash.
+//
+// Example usage:
+//
+//	cfg.WithEventHash("event_hash", "error", "route")
+//
+// Params:
+//
+//	fieldName (string): The name of the field receiving the hash.
+//	includeFields (...string): The fields, besides the level and the message, the hash is computed from.
+func (cfg *LoggerConfig) WithEventHash(fieldName string, includeFields ...string) {
+	keys := append([]string{zerolog.LevelFieldName, zerolog.MessageFieldName}, includeFields...)
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	cfg.recordOptions = append(cfg.recordOptions, func(r *record) {
+		r.set(fieldName, eventHash(r, keys))
+	})
+}
+
+// eventHash hashes the length prefixed key and canonical value of each of the sorted keys present in r.
+func eventHash(r *record, keys []string) string {
+	var buf bytes.Buffer
+	for _, key := range keys {
+		raw, ok := r.get(key)
+		if !ok {
+			continue
+		}
+		value := canonicalJSON(raw)
+
+		buf.WriteString(strconv.Itoa(len(key)))
+		buf.WriteByte(':')
+		buf.WriteString(key)
+		buf.WriteString(strconv.Itoa(len(value)))
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:eventHashSize])
+}
+
+// canonicalJSON re-encodes raw with sorted object keys, no insignificant whitespace and numbers kept as written.
+func canonicalJSON(raw json.RawMessage) []byte {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return raw
+	}
+
+	canonical, err := json.Marshal(v)
+	if err != nil {
+		return raw
+	}
+	return canonical
+}
@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithEventHash(t *testing.T) {
+	hash := func(log func(ctx context.Context)) string {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithEventHash("event_hash", "route", "details")
+		})
+
+		log(context.TODO())
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		return fields["event_hash"].(string)
+	}
+
+	base := hash(func(ctx context.Context) {
+		Error(ctx).Str("route", "/orders").Int("attempt", 1).Msg("payment failed")
+	})
+
+	t.Run("WithEventHash when events are identical should write equal hashes", func(t *testing.T) {
+		got := hash(func(ctx context.Context) {
+			Error(ctx).Str("route", "/orders").Int("attempt", 1).Msg("payment failed")
+		})
+
+		assert.Len(t, base, 32)
+		assert.Equal(t, base, got)
+	})
+
+	t.Run("WithEventHash when a field that is not included changes should write an equal hash", func(t *testing.T) {
+		got := hash(func(ctx context.Context) {
+			Error(ctx).Int("attempt", 2).Str("route", "/orders").Msg("payment failed")
+		})
+
+		assert.Equal(t, base, got)
+	})
+
+	t.Run("WithEventHash when an included field changes should write a different hash", func(t *testing.T) {
+		got := hash(func(ctx context.Context) {
+			Error(ctx).Str("route", "/refunds").Int("attempt", 1).Msg("payment failed")
+		})
+
+		assert.NotEqual(t, base, got)
+	})
+
+	t.Run("WithEventHash when the level changes should write a different hash", func(t *testing.T) {
+		got := hash(func(ctx context.Context) {
+			Warn(ctx).Str("route", "/orders").Int("attempt", 1).Msg("payment failed")
+		})
+
+		assert.NotEqual(t, base, got)
+	})
+
+	t.Run("WithEventHash when object keys are in a different order should write an equal hash", func(t *testing.T) {
+		first := hash(func(ctx context.Context) {
+			Error(ctx).RawJSON("details", []byte(`{"a":1,"b":2}`)).Msg("payment failed")
+		})
+		second := hash(func(ctx context.Context) {
+			Error(ctx).RawJSON("details", []byte(`{"b":2,"a":1}`)).Msg("payment failed")
+		})
+
+		assert.Equal(t, first, second)
+	})
+}
@@ -0,0 +1,31 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ObserveWithExemplar observes v into the observer, attaching the trace ID of the span carried by the context
+// as the 'trace_id' exemplar label, correlating the metric with the trace and the log events of the same context.
+// Observations are plain when the context does not carry a valid span context or the observer does not support exemplars.
+//
+// Example usage:
+//
+//	logger.ObserveWithExemplar(ctx, histogram.WithLabelValues("checkout"), elapsed.Seconds())
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the span.
+//	obs (prometheus.Observer): The observer, such as a histogram.
+//	v (float64): The observed value.
+func ObserveWithExemplar(ctx context.Context, obs prometheus.Observer, v float64) {
+	sc, ok := spanContext(ctx)
+	eo, supported := obs.(prometheus.ExemplarObserver)
+	if !ok || !supported {
+		obs.Observe(v)
+		return
+	}
+
+	eo.ObserveWithExemplar(v, prometheus.Labels{"trace_id": sc.TraceID().String()})
+}
@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestObserveWithExemplar(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanCtx := trace.ContextWithSpanContext(context.TODO(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  trace.SpanID{1},
+	}))
+
+	exemplars := func(h prometheus.Histogram) []*dto.Exemplar {
+		metric := &dto.Metric{}
+		assert.NoError(t, h.Write(metric))
+
+		exemplars := []*dto.Exemplar{}
+		for _, bucket := range metric.GetHistogram().GetBucket() {
+			if bucket.GetExemplar() != nil {
+				exemplars = append(exemplars, bucket.GetExemplar())
+			}
+		}
+		return exemplars
+	}
+
+	t.Run("ObserveWithExemplar when context carries a span should attach the trace ID", func(t *testing.T) {
+		h := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "duration_seconds"})
+
+		ObserveWithExemplar(spanCtx, h, 0.2)
+
+		found := exemplars(h)
+		assert.Len(t, found, 1)
+		assert.Equal(t, "trace_id", found[0].GetLabel()[0].GetName())
+		assert.Equal(t, traceID.String(), found[0].GetLabel()[0].GetValue())
+		assert.Equal(t, 0.2, found[0].GetValue())
+	})
+
+	t.Run("ObserveWithExemplar when context does not carry a span should observe without exemplar", func(t *testing.T) {
+		h := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "duration_seconds"})
+
+		ObserveWithExemplar(context.TODO(), h, 0.2)
+
+		metric := &dto.Metric{}
+		assert.NoError(t, h.Write(metric))
+		assert.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+		assert.Empty(t, exemplars(h))
+	})
+}
@@ -0,0 +1,143 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"slices"
+
+	"github.com/rs/zerolog"
+)
+
+const exitCodeFieldName = "exit_code"
+
+// exitCodeInternalFieldName is the internal field carrying the exit code of FatalCode to the exitWriter,
+// which drops it, so the code does not depend on the 'exit_code' field written by the caller.
+const exitCodeInternalFieldName = "_exit_code"
+
+var exitCodeInternalField = []byte(`"` + exitCodeInternalFieldName + `":`)
+
+type exitCodeCtxKey struct{}
+
+// exitCodeHook writes the exit code stored in the event context by FatalCode as the internal field read by the exitWriter.
+// Since it runs last, the field follows the ones written by the caller.
+var exitCodeHook = zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+	if level != zerolog.FatalLevel {
+		return
+	}
+	if code, ok := e.GetCtx().Value(exitCodeCtxKey{}).(int); ok {
+		e.Int(exitCodeInternalFieldName, code)
+	}
+})
+
+// WithExitFunc replaces the function called to terminate the program after a fatal event is written.
+// The default exit function is os.Exit.
+//
+// Example usage:
+//
+//	cfg.WithExitFunc(func(code int) {
+//	    shutdown()
+//	    os.Exit(code)
+//	}) // Runs a graceful shutdown before exiting.
+//
+// Params:
+//
+//	fn (func(code int)): The function called with the exit code of the fatal event.
+func (cfg *LoggerConfig) WithExitFunc(fn func(code int)) {
+	cfg.exitFunc = fn
+}
+
+// WithBeforeExit registers a callback run after a fatal event is written and before the exit function is called,
+// such as closing database connections or flushing metrics. Callbacks run in the reverse order of their registration,
+// before the writers are shut down, so they can still log. A panicking callback is recovered and logged at the "error" level,
+// with the 'panic' object holding the panic value, type and stack, without preventing the remaining callbacks from running.
+//
+// Example usage:
+//
+//	cfg.WithBeforeExit(func() { db.Close() })
+//
+// Params:
+//
+//	fn (func()): The callback run before exiting.
+func (cfg *LoggerConfig) WithBeforeExit(fn func()) {
+	cfg.beforeExit = append(cfg.beforeExit, fn)
+}
+
+// runBeforeExit runs the callbacks registered by WithBeforeExit, logging the recovered panics to w.
+func (cfg *LoggerConfig) runBeforeExit(w io.Writer) {
+	l := zerolog.New(w).With().Timestamp().Logger()
+
+	for i := len(cfg.beforeExit) - 1; i >= 0; i-- {
+		runBeforeExit(l, cfg.beforeExit[i])
+	}
+}
+
+func runBeforeExit(l zerolog.Logger, fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			l.Error().Dict("panic", panicDict(r)).Msg("before exit callback panicked")
+		}
+	}()
+	fn()
+}
+
+// exitWriter calls the exit function once a fatal event is written,
+// syncing the underlying writer beforehand so the message is not lost.
+type exitWriter struct {
+	w    io.Writer
+	exit func(code int)
+}
+
+func newExitWriter(w io.Writer, exit func(code int)) *exitWriter {
+	return &exitWriter{w: w, exit: exit}
+}
+
+func (w *exitWriter) Write(p []byte) (int, error) {
+	return w.w.Write(p)
+}
+
+func (w *exitWriter) Sync() error {
+	return syncWriter(w.w)
+}
+
+func (w *exitWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	if level != zerolog.FatalLevel {
+		return writeLevel(w.w, level, p)
+	}
+
+	code, n, err := w.writeFatal(p)
+
+	_ = syncWriter(w.w)
+	w.exit(code)
+
+	return n, err
+}
+
+// writeFatal writes the fatal event without the internal exit code field, returning the exit code it carries,
+// or 1 for the fatal events not created by FatalCode.
+func (w *exitWriter) writeFatal(p []byte) (int, int, error) {
+	if !bytes.Contains(p, exitCodeInternalField) {
+		n, err := writeLevel(w.w, zerolog.FatalLevel, p)
+		return 1, n, err
+	}
+
+	r, err := decodeRecord(zerolog.FatalLevel, p)
+	if err != nil {
+		n, err := writeLevel(w.w, zerolog.FatalLevel, p)
+		return 1, n, err
+	}
+	defer releaseRecord(r)
+
+	code := 1
+	for _, f := range r.fields {
+		if f.key == exitCodeInternalFieldName {
+			_ = json.Unmarshal(f.value, &code)
+		}
+	}
+	r.fields = slices.DeleteFunc(r.fields, func(f field) bool { return f.key == exitCodeInternalFieldName })
+
+	if _, err := writeLevel(w.w, zerolog.FatalLevel, r.encode()); err != nil {
+		return code, 0, err
+	}
+	return code, len(p), nil
+}
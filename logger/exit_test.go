@@ -0,0 +1,143 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+type syncBuffer struct {
+	bytes.Buffer
+	synced bool
+}
+
+func (b *syncBuffer) Sync() error {
+	b.synced = true
+	return nil
+}
+
+func TestFatalCode(t *testing.T) {
+	suts := map[string]struct {
+		act  func(ctx context.Context)
+		code int
+	}{
+		"Fatal when Msg is invoked should exit with code 1": {
+			act: func(ctx context.Context) {
+				Fatal(ctx).Msg("fatal message")
+			},
+			code: 1,
+		},
+		"FatalCode when Msg is invoked should exit with the given code": {
+			act: func(ctx context.Context) {
+				FatalCode(ctx, 3).Msg("fatal message")
+			},
+			code: 3,
+		},
+		"Fatal when exit_code field is overwritten should exit with code 1": {
+			act: func(ctx context.Context) {
+				Fatal(ctx).Int("exit_code", 0).Msg("fatal message")
+			},
+			code: 1,
+		},
+		"FatalCode when exit_code field is overwritten should exit with the given code": {
+			act: func(ctx context.Context) {
+				FatalCode(ctx, 3).Int("exit_code", 0).Msg("fatal message")
+			},
+			code: 3,
+		},
+		"FatalCode when an error is attached should exit with the given code": {
+			act: func(ctx context.Context) {
+				FatalCode(WithError(ctx, errors.New("bound")), 4).Msg("fatal message")
+			},
+			code: 4,
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &syncBuffer{}
+			var synced bool
+			code := -1
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithExitFunc(func(c int) {
+					synced = buff.synced
+					code = c
+				})
+			})
+
+			sut.act(context.TODO())
+
+			assert.Equal(t, sut.code, code)
+			assert.True(t, synced)
+			assert.Contains(t, buff.String(), "\"message\":\"fatal message\"")
+			assert.Contains(t, buff.String(), "\"level\":\"fatal\"")
+			assert.NotContains(t, buff.String(), exitCodeInternalFieldName)
+		})
+	}
+}
+
+func TestFatalContextDump(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithExitFunc(func(c int) {})
+	})
+
+	t.Run("Fatal when context carries fields, an error and a snapshot should include all of them", func(t *testing.T) {
+		buff.Reset()
+		ctx := Derive(WithContext(context.TODO(), func(c zerolog.Context) zerolog.Context {
+			return c.Str("request_id", "r-1")
+		}), func(c zerolog.Context) zerolog.Context {
+			return c.Str("step", "migrate")
+		})
+		ctx = WithError(WithSnapshot(ctx), errors.New("schema mismatch"))
+		GetSnapshot(ctx).Set("table", "orders")
+
+		Fatal(ctx).Msg("fatal message")
+
+		assert.Contains(t, buff.String(), `"request_id":"r-1","step":"migrate"`)
+		assert.Contains(t, buff.String(), `"error":"schema mismatch"`)
+		assert.Contains(t, buff.String(), `"snapshot":{"table":"orders"}`)
+	})
+
+	t.Run("Error when context carries an error and a snapshot should not include them", func(t *testing.T) {
+		buff.Reset()
+		ctx := WithError(WithSnapshot(context.TODO()), errors.New("schema mismatch"))
+		GetSnapshot(ctx).Set("table", "orders")
+
+		Error(ctx).Msg("error message")
+
+		assert.NotContains(t, buff.String(), "schema mismatch")
+		assert.NotContains(t, buff.String(), "snapshot")
+	})
+}
+
+func TestWithBeforeExit(t *testing.T) {
+	buff := &bytes.Buffer{}
+	calls := []string{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithBeforeExit(func() { calls = append(calls, "first") })
+		cfg.WithBeforeExit(func() { panic("boom") })
+		cfg.WithBeforeExit(func() { calls = append(calls, "last") })
+		cfg.WithExitFunc(func(c int) {
+			calls = append(calls, "exit")
+		})
+	})
+
+	Fatal(context.TODO()).Msg("fatal message")
+
+	t.Run("WithBeforeExit when fatal event is written should run the callbacks in reverse order before exiting", func(t *testing.T) {
+		assert.Equal(t, []string{"last", "first", "exit"}, calls)
+	})
+
+	t.Run("WithBeforeExit when callback panics should log the panic", func(t *testing.T) {
+		assert.Contains(t, buff.String(), "\"panic\":{\"type\":\"string\",\"value\":\"boom\"")
+		assert.Contains(t, buff.String(), "\"message\":\"before exit callback panicked\"")
+	})
+}
@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog"
+)
+
+type expectedErrors struct {
+	level zerolog.Level
+	errs  []error
+}
+
+// WithExpectedErrors makes Err log the errors matching any of errs, according to errors.Is, at the given level
+// instead of the "error" level, so benign errors, such as context.Canceled on client disconnects, do not raise alerts.
+// The error is still attached to the log event. It can be registered multiple times with different levels,
+// in which case the first matching registration takes precedence.
+//
+// Example usage:
+//
+//	cfg.WithExpectedErrors(zerolog.InfoLevel, context.Canceled, io.EOF)
+//	cfg.WithExpectedErrors(zerolog.WarnLevel, context.DeadlineExceeded)
+//
+// Params:
+//
+//	level (zerolog.Level): The level of the log events of the expected errors.
+//	errs (...error): The expected errors.
+func (cfg *LoggerConfig) WithExpectedErrors(level zerolog.Level, errs ...error) {
+	cfg.expectedErrors = append(cfg.expectedErrors, expectedErrors{level: level, errs: errs})
+}
+
+// errorLevel returns the level of the log events attaching err, "error" unless it is expected.
+func (cfg *LoggerConfig) errorLevel(err error) zerolog.Level {
+	for _, expected := range cfg.expectedErrors {
+		for _, target := range expected.errs {
+			if errors.Is(err, target) {
+				return expected.level
+			}
+		}
+	}
+	return zerolog.ErrorLevel
+}
@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithExpectedErrors(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithExpectedErrors(zerolog.InfoLevel, context.Canceled)
+		cfg.WithExpectedErrors(zerolog.WarnLevel, io.EOF)
+	})
+
+	suts := map[string]struct {
+		err   error
+		level string
+	}{
+		"Err when error is expected should log at the downgraded level":         {err: context.Canceled, level: "info"},
+		"Err when wrapped error is expected should log at the downgraded level": {err: fmt.Errorf("read body: %w", io.EOF), level: "warn"},
+		"Err when error is not expected should log at the error level":          {err: errors.New("declined"), level: "error"},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff.Reset()
+
+			Err(context.TODO(), sut.err).Msg("failed")
+
+			assert.Contains(t, buff.String(), fmt.Sprintf("\"level\":\"%s\"", sut.level))
+			assert.Contains(t, buff.String(), fmt.Sprintf("\"error\":%q", sut.err.Error()))
+		})
+	}
+}
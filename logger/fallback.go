@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+// WithFallbackWriter sets the output destination to primary, writing to fallback the log events that primary fails to write,
+// replacing the writer set by WithWriter. Every write is attempted on primary first, so it is used again as soon as it recovers.
+// Primary failures are reported to zerolog.ErrorHandler, and events that both writers fail to write are dropped and counted by Dropped.
+//
+// Example usage:
+//
+//	cfg.WithFallbackWriter(networkSink, os.Stderr)
+//
+// Params:
+//
+//	primary (io.Writer): The output destination for log messages.
+//	fallback (io.Writer): The output destination for the messages primary fails to write.
+func (cfg *LoggerConfig) WithFallbackWriter(primary, fallback io.Writer) {
+	cfg.w = &fallbackWriter{primary: primary, fallback: fallback}
+}
+
+type fallbackWriter struct {
+	primary  io.Writer
+	fallback io.Writer
+}
+
+func (w *fallbackWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *fallbackWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	n, err := writeLevel(w.primary, level, p)
+	if err == nil {
+		return n, nil
+	}
+
+	writeError(err)
+
+	n, err = writeLevel(w.fallback, level, p)
+	if err != nil {
+		dropRendered(DropWriteFailed, level, p)
+	}
+	return n, err
+}
+
+func (w *fallbackWriter) Sync() error {
+	_ = syncWriter(w.primary)
+	return syncWriter(w.fallback)
+}
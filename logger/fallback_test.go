@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingWriter struct {
+	bytes.Buffer
+	fail bool
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.fail {
+		return 0, errors.New("write failed")
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestWithFallbackWriter(t *testing.T) {
+	primary, fallback := &failingWriter{}, &failingWriter{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithFallbackWriter(primary, fallback)
+	})
+	var reported []error
+	zerolog.ErrorHandler = func(err error) {
+		reported = append(reported, err)
+	}
+	t.Cleanup(func() {
+		zerolog.ErrorHandler = nil
+	})
+
+	t.Run("WithFallbackWriter when primary fails should write to the fallback", func(t *testing.T) {
+		primary.fail = true
+
+		Info(context.TODO()).Msg("failed over")
+
+		assert.Empty(t, primary.String())
+		assert.Contains(t, fallback.String(), "\"message\":\"failed over\"")
+		assert.Len(t, reported, 1)
+	})
+
+	t.Run("WithFallbackWriter when primary recovers should write to the primary", func(t *testing.T) {
+		primary.fail = false
+		fallback.Reset()
+
+		Info(context.TODO()).Msg("recovered")
+
+		assert.Contains(t, primary.String(), "\"message\":\"recovered\"")
+		assert.Empty(t, fallback.String())
+	})
+
+	t.Run("WithFallbackWriter when both writers fail should drop and count the event", func(t *testing.T) {
+		primary.fail, fallback.fail = true, true
+		before := Dropped()
+
+		Info(context.TODO()).Msg("lost")
+
+		assert.Equal(t, uint64(1), Dropped()-before)
+	})
+}
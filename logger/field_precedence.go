@@ -0,0 +1,40 @@
+package logger
+
+// WithFieldPrecedence coalesces duplicate keys of every log event at write time, such as the 'trace_id' field
+// written by two event options, producing a single value per key in the position of its first occurrence.
+// The last value written takes precedence, so the fields of the log event override the ones added by the context
+// and the event options. The keys listed by order take the first value written instead, protecting fields,
+// such as the ones set by context extractors, from being overridden later on.
+//
+// Example usage:
+//
+//	cfg.WithFieldPrecedence([]string{"trace_id", "span_id"})
+//
+// Params:
+//
+//	order ([]string): The keys whose first value takes precedence over the ones written later.
+func (cfg *LoggerConfig) WithFieldPrecedence(order []string) {
+	first := make(map[string]bool, len(order))
+	for _, key := range order {
+		first[key] = true
+	}
+
+	cfg.recordOptions = append(cfg.recordOptions, func(r *record) {
+		index := make(map[string]int, len(r.fields))
+		fields := make([]field, 0, len(r.fields))
+
+		for _, f := range r.fields {
+			i, ok := index[f.key]
+			if !ok {
+				index[f.key] = len(fields)
+				fields = append(fields, f)
+				continue
+			}
+			if !first[f.key] {
+				fields[i].value = f.value
+			}
+		}
+
+		r.fields = fields
+	})
+}
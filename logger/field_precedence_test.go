@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithFieldPrecedence(t *testing.T) {
+	traceID := func(id string) LogEventOption {
+		return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+			return e.Str("trace_id", id)
+		}
+	}
+
+	suts := map[string]struct {
+		order    []string
+		expected string
+	}{
+		"WithFieldPrecedence when key is not ordered should keep the last value":     {order: nil, expected: "\"trace_id\":\"second\""},
+		"WithFieldPrecedence when key is ordered should keep the first value":        {order: []string{"trace_id"}, expected: "\"trace_id\":\"first\""},
+		"WithFieldPrecedence when other keys are ordered should keep the last value": {order: []string{"span_id"}, expected: "\"trace_id\":\"second\""},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithEventFields(traceID("first"))
+				cfg.WithEventFields(traceID("second"))
+				cfg.WithFieldPrecedence(sut.order)
+			})
+
+			Info(context.TODO()).Msg("deduplicated")
+			msg := buff.String()
+
+			assert.Equal(t, 1, strings.Count(msg, "\"trace_id\""))
+			assert.Contains(t, msg, sut.expected)
+		})
+	}
+}
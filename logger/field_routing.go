@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"errors"
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+type fieldRoute struct {
+	key       string
+	value     string
+	w         io.Writer
+	exclusive bool
+}
+
+// matches reports whether the value of the field of r is value, either as a string or as the rendered JSON value,
+// so "true" matches both the boolean true and the string "true".
+func (route fieldRoute) matches(r *record) bool {
+	raw, ok := r.get(route.key)
+	if !ok {
+		return false
+	}
+	if s, ok := r.str(route.key); ok {
+		return s == route.value
+	}
+	return string(raw) == route.value
+}
+
+// WithFieldRoutedWriter also writes the log events whose field fieldKey has the value fieldValue to w,
+// such as the events tagged audit=true to an audit sink. The events keep being written to the default writer;
+// use WithExclusiveFieldRoutedWriter to remove them from it. Several routes can be set, and an event matching
+// more than one is written to each of them.
+//
+// Example usage:
+//
+//	cfg.WithFieldRoutedWriter("audit", "true", auditSink)
+//
+// Params:
+//
+//	fieldKey (string): The name of the field to inspect.
+//	fieldValue (string): The value routing the log event, compared to string values or to the rendered JSON value otherwise.
+//	w (io.Writer): The output destination for the matching log events.
+func (cfg *LoggerConfig) WithFieldRoutedWriter(fieldKey, fieldValue string, w io.Writer) {
+	cfg.fieldRoutes = append(cfg.fieldRoutes, fieldRoute{key: fieldKey, value: fieldValue, w: w})
+}
+
+// WithExclusiveFieldRoutedWriter writes the log events whose field fieldKey has the value fieldValue to w
+// instead of the default writer. It otherwise behaves as WithFieldRoutedWriter.
+//
+// Example usage:
+//
+//	cfg.WithExclusiveFieldRoutedWriter("audit", "true", auditSink)
+//
+// Params:
+//
+//	fieldKey (string): The name of the field to inspect.
+//	fieldValue (string): The value routing the log event, compared to string values or to the rendered JSON value otherwise.
+//	w (io.Writer): The output destination for the matching log events.
+func (cfg *LoggerConfig) WithExclusiveFieldRoutedWriter(fieldKey, fieldValue string, w io.Writer) {
+	cfg.fieldRoutes = append(cfg.fieldRoutes, fieldRoute{key: fieldKey, value: fieldValue, w: w, exclusive: true})
+}
+
+func (cfg *LoggerConfig) fieldRoutedWriter(w io.Writer, format Format) *fieldRoutedWriter {
+	routes := make([]fieldRoute, len(cfg.fieldRoutes))
+	for i, route := range cfg.fieldRoutes {
+		route.w = cfg.encoder(route.w, format)
+		routes[i] = route
+	}
+	return &fieldRoutedWriter{w: w, routes: routes}
+}
+
+// fieldRoutedWriter dispatches each log event to the routes matching its fields, and to the default writer
+// unless an exclusive route matched.
+type fieldRoutedWriter struct {
+	w      io.Writer
+	routes []fieldRoute
+}
+
+func (w *fieldRoutedWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *fieldRoutedWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	r, err := decodeRecord(level, p)
+	if err != nil {
+		return writeLevel(w.w, level, p)
+	}
+	defer releaseRecord(r)
+
+	var errs []error
+	excluded := false
+	for _, route := range w.routes {
+		if !route.matches(r) {
+			continue
+		}
+		if _, err := writeLevel(route.w, level, p); err != nil {
+			errs = append(errs, err)
+		}
+		excluded = excluded || route.exclusive
+	}
+
+	if !excluded {
+		if _, err := writeLevel(w.w, level, p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Sync flushes the default and routed writers when supported.
+func (w *fieldRoutedWriter) Sync() error {
+	errs := []error{syncWriter(w.w)}
+	for _, route := range w.routes {
+		errs = append(errs, syncWriter(route.w))
+	}
+	return errors.Join(errs...)
+}
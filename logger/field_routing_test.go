@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithFieldRoutedWriter(t *testing.T) {
+	t.Run("WithFieldRoutedWriter when field matches should write to the routed and default writers", func(t *testing.T) {
+		out, audit := &bytes.Buffer{}, &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(out)
+			cfg.WithFieldRoutedWriter("audit", "true", audit)
+		})
+
+		Info(context.TODO()).Bool("audit", true).Msg("audited")
+		Info(context.TODO()).Msg("normal")
+
+		assert.Contains(t, audit.String(), "\"message\":\"audited\"")
+		assert.NotContains(t, audit.String(), "\"message\":\"normal\"")
+		assert.Contains(t, out.String(), "\"message\":\"audited\"")
+		assert.Contains(t, out.String(), "\"message\":\"normal\"")
+	})
+
+	t.Run("WithExclusiveFieldRoutedWriter when field matches should not write to the default writer", func(t *testing.T) {
+		out, audit := &bytes.Buffer{}, &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(out)
+			cfg.WithExclusiveFieldRoutedWriter("audit", "true", audit)
+		})
+
+		Info(context.TODO()).Bool("audit", true).Msg("audited")
+		Info(context.TODO()).Bool("audit", false).Msg("normal")
+
+		assert.Contains(t, audit.String(), "\"message\":\"audited\"")
+		assert.NotContains(t, audit.String(), "\"message\":\"normal\"")
+		assert.NotContains(t, out.String(), "\"message\":\"audited\"")
+		assert.Contains(t, out.String(), "\"message\":\"normal\"")
+	})
+
+	t.Run("WithFieldRoutedWriter when several routes are set should write to each matching one", func(t *testing.T) {
+		out, audit, billing := &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(out)
+			cfg.WithExclusiveFieldRoutedWriter("audit", "true", audit)
+			cfg.WithExclusiveFieldRoutedWriter("domain", "billing", billing)
+		})
+
+		Info(context.TODO()).Bool("audit", true).Msg("audited")
+		Info(context.TODO()).Str("domain", "billing").Msg("charged")
+		Info(context.TODO()).Bool("audit", true).Str("domain", "billing").Msg("refunded")
+
+		assert.Equal(t, []string{"audited", "refunded"}, messages(audit.String()))
+		assert.Equal(t, []string{"charged", "refunded"}, messages(billing.String()))
+		assert.Empty(t, out.String())
+	})
+}
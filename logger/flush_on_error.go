@@ -0,0 +1,48 @@
+package logger
+
+import "github.com/rs/zerolog"
+
+// flusher is implemented by the writers holding log events in memory, such as the buffered and batching writers.
+type flusher interface {
+	flush()
+}
+
+// WithFlushOnError flushes the writers holding log events in memory, set by WithBufferedWriter and WithBatchWriter,
+// right after each event at the "error" level or above is written, so errors reach their destination without waiting
+// behind a backlog or for the next flush interval. The held log events are flushed in order, so the error is delivered
+// along with the log events written before it. It has no effect when no such writer is configured.
+//
+// Example usage:
+//
+//	cfg.WithBatchWriter(send, 500, 5*time.Second)
+//	cfg.WithFlushOnError()
+func (cfg *LoggerConfig) WithFlushOnError() {
+	cfg.flushOnError = true
+}
+
+// flushOnErrorWriter flushes the writers holding log events in memory after each error event.
+type flushOnErrorWriter struct {
+	w        zerolog.LevelWriter
+	flushers []flusher // Ordered from the innermost writer to the outermost one.
+}
+
+func (w *flushOnErrorWriter) Write(p []byte) (int, error) {
+	return w.w.Write(p)
+}
+
+func (w *flushOnErrorWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	n, err := w.w.WriteLevel(level, p)
+
+	if level >= zerolog.ErrorLevel && level <= zerolog.PanicLevel {
+		// The outermost writers are flushed first, so the error reaches the innermost ones before they are flushed.
+		for i := len(w.flushers) - 1; i >= 0; i-- {
+			w.flushers[i].flush()
+		}
+	}
+
+	return n, err
+}
+
+func (w *flushOnErrorWriter) Sync() error {
+	return syncWriter(w.w)
+}
@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithFlushOnError(t *testing.T) {
+	t.Run("WithFlushOnError when error is written should deliver the batch before the interval elapses", func(t *testing.T) {
+		r := &batchRecorder{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithBufferedWriter(16, time.Millisecond)
+			cfg.WithBatchWriter(r.flush, 100, time.Hour)
+			cfg.WithFlushOnError()
+		})
+
+		Info(context.TODO()).Msg("first")
+		Err(context.TODO(), errors.New("boom")).Msg("second")
+
+		assert.Equal(t, [][]string{{"first", "second"}}, r.flushed())
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+
+	t.Run("WithFlushOnError when info is written should keep holding the batch", func(t *testing.T) {
+		r := &batchRecorder{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithBatchWriter(r.flush, 100, time.Hour)
+			cfg.WithFlushOnError()
+		})
+
+		Info(context.TODO()).Msg("first")
+
+		assert.Empty(t, r.flushed())
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+
+	t.Run("WithFlushOnError when flush function logs an error should not deadlock", func(t *testing.T) {
+		r := &batchRecorder{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithBatchWriter(func(ctx context.Context, batch [][]byte) error {
+				if message(batch[0]) == "first" {
+					Err(ctx, errors.New("slow ingestion")).Msg("flush degraded")
+				}
+				return r.flush(ctx, batch)
+			}, 100, time.Hour)
+			cfg.WithFlushOnError()
+		})
+
+		Err(context.TODO(), errors.New("boom")).Msg("first")
+
+		assert.Eventually(t, func() bool { return len(r.flushed()) == 2 }, time.Second, time.Millisecond)
+		assert.Equal(t, [][]string{{"first"}, {"flush degraded"}}, r.flushed())
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+
+	t.Run("WithFlushOnError when another goroutine logs an error during a flush should wait for its delivery", func(t *testing.T) {
+		r := &batchRecorder{}
+		started, release := make(chan struct{}), make(chan struct{})
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithBatchWriter(func(ctx context.Context, batch [][]byte) error {
+				if message(batch[0]) == "first" {
+					close(started)
+					<-release
+				}
+				return r.flush(ctx, batch)
+			}, 1, time.Hour)
+			cfg.WithFlushOnError()
+		})
+
+		Info(context.TODO()).Msg("first")
+		<-started
+
+		delivered := make(chan [][]string)
+		go func() {
+			Err(context.TODO(), errors.New("boom")).Msg("second")
+			delivered <- r.flushed()
+		}()
+
+		select {
+		case <-delivered:
+			t.Fatal("error event returned before being delivered")
+		case <-time.After(20 * time.Millisecond):
+		}
+		close(release)
+
+		assert.Equal(t, [][]string{{"first"}, {"second"}}, <-delivered)
+		assert.NoError(t, Shutdown(context.Background()))
+	})
+}
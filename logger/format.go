@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"context"
+	"io"
+)
+
+// formatDefault is the format of the log events of the contexts without a format set by WithFormat,
+// rendered with the configured encoder.
+const formatDefault Format = -1
+
+type formatCtxKey struct{}
+
+// WithFormat stores a logger derived from the context logger into the context, rendering its log events with the given format
+// regardless of the format of the global logger, so a single request can opt into FormatConsole while everything else stays FormatJSON.
+// The derived logger writes through its own writer chain, built on first use, sharing the output destination of the global logger.
+// The log events still go through the configured hooks, record options and writer options, and only their encoding changes,
+// although stateful writer options, such as WithTailSampling, keep their state apart for each format.
+// When called more than once on the same context chain, the last format wins.
+//
+// Example usage:
+//
+//	if r.Header.Get("X-Debug-Pretty") != "" {
+//	    ctx = logger.WithFormat(ctx, logger.FormatConsole)
+//	}
+//	logger.Info(ctx).Msg("rendered for humans")
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the parent logger.
+//	format (Format): The encoding used to render the log events of the context.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the derived logger.
+func WithFormat(ctx context.Context, format Format) context.Context {
+	return wrapOutput(context.WithValue(ctx, formatCtxKey{}, format))
+}
+
+// contextFormat returns the format set by WithFormat on the context, or formatDefault.
+func contextFormat(ctx context.Context) Format {
+	if format, ok := ctx.Value(formatCtxKey{}).(Format); ok {
+		return format
+	}
+	return formatDefault
+}
+
+// output returns the writer chain rendering the log events with the format, building it on first use.
+func (cfg *LoggerConfig) output(format Format) io.Writer {
+	if format == formatDefault {
+		return cfg.out
+	}
+
+	cfg.mu.Lock()
+	defer cfg.mu.Unlock()
+
+	w, ok := cfg.formatOuts[format]
+	if !ok {
+		w = cfg.chain(format)
+		if cfg.formatOuts == nil {
+			cfg.formatOuts = map[Format]io.Writer{}
+		}
+		cfg.formatOuts[format] = w
+	}
+	return w
+}
+
+// encoder returns the writer rendering the log events into w with the format of their context,
+// or with the configured encoder when the context has no format. Every output destination is written through it,
+// so the envelope set by WithEnvelope is applied once the log events are routed, and the destination is part of the request blocks.
+func (cfg *LoggerConfig) encoder(w io.Writer, format Format) io.Writer {
+	enc := cfg.formatted(cfg.blocked(w), format)
+	if cfg.envelope != nil {
+		return &recordWriter{w: levelWriter(enc), opts: []recordOption{cfg.envelope}}
+	}
+	return enc
+}
+
+func (cfg *LoggerConfig) formatted(w io.Writer, format Format) io.Writer {
+	switch format {
+	case FormatJSON:
+		return w
+	case FormatConsole:
+		return cfg.consoleWriter(w)
+	}
+	return cfg.formatEncoder(w)
+}
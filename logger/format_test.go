@@ -0,0 +1,99 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithFormat(t *testing.T) {
+	t.Run("WithFormat when context opts into console should render its log events for humans", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+		})
+		ctx := WithFormat(context.TODO(), FormatConsole)
+
+		Info(ctx).Str("order_id", "42").Msg("pretty")
+
+		out := buff.String()
+		assert.False(t, json.Valid(buff.Bytes()))
+		assert.Contains(t, out, "pretty")
+		assert.Contains(t, out, "order_id=")
+	})
+
+	t.Run("WithFormat when record options are set should not expose the format to them", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithMaxFields(4)
+		})
+		ctx := WithFormat(context.TODO(), FormatJSON)
+
+		Info(ctx).Str("a", "1").Msg("machine")
+
+		assertJSONFormat(t, buff.Bytes())
+		assert.NotContains(t, buff.String(), "fields_truncated")
+	})
+
+	t.Run("WithFormat when context captures its log events should keep capturing them", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+		})
+		ctx, capture := WithTestCapture(context.TODO())
+		ctx = WithFormat(ctx, FormatConsole)
+
+		Info(ctx).Msg("pretty")
+
+		assert.True(t, capture.Contains(zerolog.InfoLevel, "pretty"))
+		assert.False(t, json.Valid(buff.Bytes()))
+	})
+
+	t.Run("WithFormat when context does not opt in should keep rendering JSON", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+		})
+		WithFormat(context.TODO(), FormatConsole)
+
+		Info(context.TODO()).Msg("machine")
+
+		assertJSONFormat(t, buff.Bytes())
+	})
+
+	t.Run("WithFormat when context opts into JSON under the console format should render JSON", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithFormat(FormatConsole)
+		})
+		ctx := WithFormat(context.TODO(), FormatJSON)
+
+		Info(ctx).Msg("machine")
+
+		assertJSONFormat(t, buff.Bytes())
+	})
+
+	t.Run("WithFormat when called again on a derived context should use the last format", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+		})
+		ctx := WithFormat(WithFormat(context.TODO(), FormatConsole), FormatJSON)
+
+		Info(ctx).Msg("machine")
+
+		assertJSONFormat(t, buff.Bytes())
+	})
+}
+
+func assertJSONFormat(t *testing.T, p []byte) {
+	var fields map[string]any
+	assert.NoError(t, json.Unmarshal(p, &fields))
+	assert.Equal(t, "machine", fields["message"])
+}
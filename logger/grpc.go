@@ -0,0 +1,113 @@
+package logger
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// FromIncomingGRPCMetadata stores a logger carrying the given keys of the incoming gRPC metadata into the context,
+// derived from the context logger, so they are written on every log event created with the returned context,
+// including the ones created directly with the logger returned by FromContext. Fields are named after the keys,
+// lowercased with dashes replaced by underscores, such as 'x_request_id' for "X-Request-Id".
+// Only the first value of multi-value keys is written, truncated to 256 bytes, and missing keys are skipped.
+//
+// Example usage:
+//
+//	ctx = logger.FromIncomingGRPCMetadata(ctx, "request-id", "tenant")
+//	logger.Info(ctx).Msg("order created") // "request_id":"4f2a","tenant":"acme"
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the incoming metadata and the parent logger.
+//	keys (...string): The metadata keys written as fields.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the logger with the metadata fields.
+func FromIncomingGRPCMetadata(ctx context.Context, keys ...string) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(keys) == 0 {
+		return ctx
+	}
+
+	return Derive(ctx, func(c zerolog.Context) zerolog.Context {
+		for _, key := range keys {
+			if values := md.Get(key); len(values) > 0 {
+				c = c.Str(grpcFieldName(key), truncate(values[0], maxHeaderTagLength))
+			}
+		}
+		return c
+	})
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor storing the given keys of the incoming metadata into the context
+// of each call with FromIncomingGRPCMetadata, so every log event created by the handlers carries them.
+//
+// Example usage:
+//
+//	server := grpc.NewServer(grpc.UnaryInterceptor(logger.UnaryServerInterceptor("request-id", "tenant")))
+//
+// Params:
+//
+//	keys (...string): The metadata keys written as fields.
+//
+// Returns:
+//
+//	grpc.UnaryServerInterceptor: The interceptor to be passed to the gRPC server.
+func UnaryServerInterceptor(keys ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(FromIncomingGRPCMetadata(ctx, keys...), req)
+	}
+}
+
+// StatusErr logs a gRPC status at the return site and returns it as an error, keeping the logged and returned codes consistent.
+// The log event has the message msg, the 'grpc_code' field with the code name, such as "NotFound", and the cause as the 'error' field,
+// which is omitted when cause is nil. It is logged at the "error" level for server-side failures, such as Internal and Unavailable,
+// at the "warn" level for client-side failures, such as InvalidArgument and NotFound, and at the "info" level for OK.
+//
+// Example usage:
+//
+//	order, err := repo.Find(ctx, req.GetId())
+//	if err != nil {
+//	    return nil, logger.StatusErr(ctx, codes.NotFound, "order not found", err)
+//	}
+//
+// Params:
+//
+//	ctx (context.Context): The context from which to extract tracing information.
+//	code (codes.Code): The gRPC status code.
+//	msg (string): The status message, also used as the log message.
+//	cause (error): The error causing the status, or nil.
+//
+// Returns:
+//
+//	error: The status error, carrying code and msg, or nil when code is OK.
+func StatusErr(ctx context.Context, code codes.Code, msg string, cause error) error {
+	e := errEvent(ctx, newEvent(ctx, grpcCodeLevel(code)), cause).Str("grpc_code", code.String())
+	event(ctx, e).Msg(msg)
+
+	return status.Error(code, msg)
+}
+
+// grpcCodeLevel maps the gRPC status codes to levels, following the HTTP status mapping of the HTTP middleware.
+func grpcCodeLevel(code codes.Code) zerolog.Level {
+	switch code {
+	case codes.OK:
+		return zerolog.InfoLevel
+	case codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied,
+		codes.ResourceExhausted, codes.FailedPrecondition, codes.Aborted, codes.OutOfRange, codes.Unauthenticated:
+		return zerolog.WarnLevel
+	default:
+		return zerolog.ErrorLevel
+	}
+}
+
+func grpcFieldName(key string) string {
+	return strings.ReplaceAll(strings.ToLower(key), "-", "_")
+}
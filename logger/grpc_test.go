@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestFromIncomingGRPCMetadata(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	md := metadata.MD{}
+	md.Append("request-id", "4f2a", "9c1b")
+	md.Append("tenant", "acme")
+	md.Append("X-Request-Id", "7d3e")
+	incoming := metadata.NewIncomingContext(context.TODO(), md)
+
+	t.Run("FromIncomingGRPCMetadata when keys are present should write the first values", func(t *testing.T) {
+		Info(FromIncomingGRPCMetadata(incoming, "request-id", "tenant", "missing")).Msg("test")
+
+		f := fields()
+		assert.Equal(t, "4f2a", f["request_id"])
+		assert.Equal(t, "acme", f["tenant"])
+		assert.NotContains(t, f, "missing")
+	})
+
+	t.Run("FromIncomingGRPCMetadata when key is prefixed should keep the prefix in the field name", func(t *testing.T) {
+		Info(FromIncomingGRPCMetadata(incoming, "X-Request-Id")).Msg("test")
+
+		assert.Equal(t, "7d3e", fields()["x_request_id"])
+	})
+
+	t.Run("FromIncomingGRPCMetadata when context does not carry metadata should not change the context", func(t *testing.T) {
+		ctx := context.TODO()
+
+		assert.Equal(t, ctx, FromIncomingGRPCMetadata(ctx, "tenant"))
+	})
+
+	t.Run("UnaryServerInterceptor when called should store the metadata fields in the handler context", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor("tenant")
+
+		_, err := interceptor(incoming, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+			Info(ctx).Msg("handled")
+			return nil, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "acme", fields()["tenant"])
+	})
+}
+
+func TestStatusErr(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	suts := map[string]struct {
+		code  codes.Code
+		cause error
+		level string
+	}{
+		"StatusErr when code is Internal should log at the error level": {
+			code:  codes.Internal,
+			cause: errors.New("connection reset"),
+			level: "error",
+		},
+		"StatusErr when code is NotFound should log at the warn level": {
+			code:  codes.NotFound,
+			cause: errors.New("no rows"),
+			level: "warn",
+		},
+		"StatusErr when code is OK should log at the info level": {
+			code:  codes.OK,
+			level: "info",
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff.Reset()
+
+			err := StatusErr(context.TODO(), sut.code, "order lookup", sut.cause)
+
+			var fields map[string]any
+			assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+			assert.Equal(t, sut.level, fields["level"])
+			assert.Equal(t, sut.code.String(), fields["grpc_code"])
+			assert.Equal(t, "order lookup", fields["message"])
+			if sut.cause != nil {
+				assert.Equal(t, sut.cause.Error(), fields["error"])
+			} else {
+				assert.NotContains(t, fields, "error")
+			}
+
+			assert.Equal(t, sut.code, status.Code(err))
+			if sut.code == codes.OK {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, "order lookup", status.Convert(err).Message())
+			}
+		})
+	}
+}
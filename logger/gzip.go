@@ -0,0 +1,140 @@
+package logger
+
+import (
+	"compress/gzip"
+	"os"
+	"sync"
+	"time"
+)
+
+type gzipFile struct {
+	path  string
+	level int
+}
+
+// WithGzipFile sets a gzip compressed file as the output destination, replacing the writer set by WithWriter.
+// Log events are written as newline-delimited JSON and flushed to the file at the flush interval, on Sync and on Shutdown.
+// Shutdown must be called before the program exits so the file is not left corrupt.
+// The file is created, or appended to, on the first write.
+//
+// Example usage:
+//
+//	cfg.WithGzipFile("/var/log/batch.jsonl.gz", gzip.BestSpeed)
+//
+// Params:
+//
+//	path (string): The path of the compressed file.
+//	level (int): The gzip compression level, such as gzip.DefaultCompression.
+func (cfg *LoggerConfig) WithGzipFile(path string, level int) {
+	cfg.gzipFile = &gzipFile{path: path, level: level}
+}
+
+// WithFlushInterval sets the interval at which buffered writers, such as compressed files, are flushed.
+// The default interval is one second, and an interval of zero or less disables the periodic flushes,
+// so buffered log events are flushed on Sync and on Shutdown only.
+//
+// Example usage:
+//
+//	cfg.WithFlushInterval(10 * time.Second)
+//
+// Params:
+//
+//	d (time.Duration): The interval between flushes.
+func (cfg *LoggerConfig) WithFlushInterval(d time.Duration) {
+	cfg.flushInterval = d
+}
+
+type gzipFileWriter struct {
+	path     string
+	level    int
+	interval time.Duration
+	mu       sync.Mutex
+	f        *os.File
+	gz       *gzip.Writer
+	stop     chan struct{}
+}
+
+func newGzipFileWriter(path string, level int, interval time.Duration) *gzipFileWriter {
+	return &gzipFileWriter{path: path, level: level, interval: interval}
+}
+
+func (w *gzipFileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.gz == nil {
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	return w.gz.Write(p)
+}
+
+func (w *gzipFileWriter) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	gz, err := gzip.NewWriterLevel(f, w.level)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	w.f, w.gz, w.stop = f, gz, make(chan struct{})
+	if w.interval > 0 {
+		go w.flushLoop(w.stop)
+	}
+
+	return nil
+}
+
+func (w *gzipFileWriter) flushLoop(stop chan struct{}) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_ = w.Sync()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Sync flushes the compressed log events to the file.
+func (w *gzipFileWriter) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.gz == nil {
+		return nil
+	}
+
+	if err := w.gz.Flush(); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+// Close writes the gzip footer and closes the file. The file is reopened, appending a new gzip member, on the next write.
+func (w *gzipFileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.gz == nil {
+		return nil
+	}
+
+	close(w.stop)
+	err := w.gz.Close()
+	if cErr := w.f.Close(); err == nil {
+		err = cErr
+	}
+	w.f, w.gz = nil, nil
+
+	return err
+}
@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithGzipFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.jsonl.gz")
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithGzipFile(path, gzip.BestSpeed)
+	})
+	t.Cleanup(func() {
+		_ = Shutdown(context.TODO())
+	})
+
+	Info(context.TODO()).Msg("first")
+	Info(context.TODO()).Msg("second")
+
+	t.Run("Sync when events are written should flush the compressed records", func(t *testing.T) {
+		assert.NoError(t, Sync())
+		assert.Equal(t, []string{"first", "second"}, readGzipMessages(t, path, false))
+	})
+
+	t.Run("Shutdown when events are written should leave a valid compressed file", func(t *testing.T) {
+		assert.NoError(t, Shutdown(context.TODO()))
+		assert.Equal(t, []string{"first", "second"}, readGzipMessages(t, path, true))
+	})
+}
+
+func TestWithGzipFileReconfigure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.jsonl.gz")
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithGzipFile(path, gzip.BestSpeed)
+		cfg.WithFlushInterval(0)
+	})
+
+	Info(context.TODO()).Msg("first")
+
+	t.Run("Configure when a compressed file is open should close it", func(t *testing.T) {
+		Configure()
+
+		assert.Equal(t, []string{"first"}, readGzipMessages(t, path, true))
+	})
+}
+
+func readGzipMessages(t *testing.T, path string, complete bool) []string {
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	assert.NoError(t, err)
+
+	messages := []string{}
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		messages = append(messages, message(scanner.Bytes()))
+	}
+
+	if complete {
+		assert.NoError(t, scanner.Err())
+	}
+
+	return messages
+}
@@ -0,0 +1,345 @@
+package logger
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+)
+
+const (
+	unknownRoute       = "unknown"
+	maxHeaderTagLength = 256
+)
+
+type httpMiddlewareConfig struct {
+	histogram        prometheus.ObserverVec       // Histogram observing the request durations.
+	route            func(r *http.Request) string // Function resolving the matched route pattern of a request.
+	headerTags       map[string]string            // Request headers added to the request logger, by field name.
+	acceptLanguage   bool                         // Whether the request locale is stored into the request context.
+	suppressPaths    []string                     // Paths whose successful requests are not logged.
+	correlationChain bool                         // Whether the correlation chain is stored into the request context.
+	requestIDHeader  string                       // Request header carrying the local request ID of the correlation chain.
+	traceparent      bool                         // Whether the span context of the 'traceparent' header is stored into the request context.
+	sourceService    bool                         // Whether the 'X-Source-Service' header is added to the request logger.
+}
+
+// HTTPMiddlewareOption represents a function that modifies the HTTP middleware configuration.
+type HTTPMiddlewareOption func(cfg *httpMiddlewareConfig)
+
+// WithLatencyHistogram makes the HTTP middleware observe the duration of each request, in seconds, into the histogram.
+// The histogram must have two labels, filled in order with the matched route pattern and the status class (e.g. "2xx").
+// Requests whose route can not be resolved are labeled with the "unknown" route, avoiding high cardinality from raw paths.
+//
+// Example usage:
+//
+//	histogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+//	    Name: "http_request_duration_seconds",
+//	}, []string{"route", "status"})
+//	handler := logger.HTTPMiddleware(logger.WithLatencyHistogram(histogram))(mux)
+//
+// Params:
+//
+//	h (prometheus.ObserverVec): The histogram observing the request durations.
+//
+// Returns:
+//
+//	HTTPMiddlewareOption: The option to be passed to HTTPMiddleware.
+func WithLatencyHistogram(h prometheus.ObserverVec) HTTPMiddlewareOption {
+	return func(cfg *httpMiddlewareConfig) {
+		cfg.histogram = h
+	}
+}
+
+// WithRouteFunc sets the function resolving the matched route pattern of a request, such as "/users/{id}".
+// By default, the route is resolved from the wrapped handler when it is a *http.ServeMux.
+// Routers storing the matched route in the request context, such as chi, should provide it through this option.
+//
+// Example usage:
+//
+//	logger.WithRouteFunc(func(r *http.Request) string {
+//	    return chi.RouteContext(r.Context()).RoutePattern()
+//	})
+//
+// Params:
+//
+//	fn (func(r *http.Request) string): The function resolving the route pattern, returning an empty string if unknown.
+//
+// Returns:
+//
+//	HTTPMiddlewareOption: The option to be passed to HTTPMiddleware.
+func WithRouteFunc(fn func(r *http.Request) string) HTTPMiddlewareOption {
+	return func(cfg *httpMiddlewareConfig) {
+		cfg.route = fn
+	}
+}
+
+// WithHeaderTags makes the HTTP middleware add the given request headers as fields of the request logger,
+// so every log event created with the request context carries them. Missing headers are skipped,
+// and values are truncated to 256 bytes to prevent abuse.
+//
+// Example usage:
+//
+//	logger.WithHeaderTags(map[string]string{
+//	    "X-Request-ID": "request_id",
+//	    "X-Tenant-ID":  "tenant_id",
+//	})
+//
+// Params:
+//
+//	mapping (map[string]string): The log field names, by header name.
+//
+// Returns:
+//
+//	HTTPMiddlewareOption: The option to be passed to HTTPMiddleware.
+func WithHeaderTags(mapping map[string]string) HTTPMiddlewareOption {
+	return func(cfg *httpMiddlewareConfig) {
+		if cfg.headerTags == nil {
+			cfg.headerTags = map[string]string{}
+		}
+		for header, name := range mapping {
+			cfg.headerTags[name] = header
+		}
+	}
+}
+
+// WithSuppressPaths makes the HTTP middleware skip the logging of successful requests to the given paths,
+// such as health check probes. Paths ending with a slash match every path under them, while other paths must match exactly.
+// Requests to the suppressed paths with a non-2xx response are still logged, at the "warn" level, so probe failures are visible.
+//
+// Example usage:
+//
+//	logger.WithSuppressPaths("/healthz", "/readyz", "/internal/probes/")
+//
+// Params:
+//
+//	paths (...string): The suppressed paths.
+//
+// Returns:
+//
+//	HTTPMiddlewareOption: The option to be passed to HTTPMiddleware.
+func WithSuppressPaths(paths ...string) HTTPMiddlewareOption {
+	return func(cfg *httpMiddlewareConfig) {
+		cfg.suppressPaths = append(cfg.suppressPaths, paths...)
+	}
+}
+
+// HTTPMiddleware returns a middleware that logs every request once the response is written,
+// including the 'method', 'path', 'url', 'route', 'status' and 'duration_ms' fields.
+// The 'url' field holds the request URI with its sensitive query parameters masked by SanitizeURL.
+// The route, when resolved before calling the wrapped handler, is stored into the request context with WithRoute.
+// The start of the request is stored into the request context with MarkStart.
+// Requests are logged at the "error" level for 5xx responses, "warn" for 4xx responses and "info" otherwise.
+// Requests whose handler panics are logged with the 'panic' field, and with the 500 status when no header was written,
+// before the panic is propagated.
+//
+// Example usage:
+//
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("GET /users/{id}", getUser)
+//	http.ListenAndServe(":8080", logger.HTTPMiddleware()(mux))
+//
+// Params:
+//
+//	opts (...logger.HTTPMiddlewareOption): Optional functions that modifies the middleware configuration.
+//
+// Returns:
+//
+//	func(next http.Handler) http.Handler: The middleware wrapping the next handler.
+func HTTPMiddleware(opts ...HTTPMiddlewareOption) func(next http.Handler) http.Handler {
+	mcfg := &httpMiddlewareConfig{}
+
+	for _, opt := range opts {
+		opt(mcfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		route := mcfg.route
+		if route == nil {
+			route = routeFunc(next)
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cfg := config()
+			start := time.Now()
+			rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+
+			// The held log events are written last, once the request is logged.
+			if cfg.requestBuffer != nil {
+				ctx, flush := bufferRequest(r.Context(), cfg.requestBuffer)
+				defer flush()
+				r = r.WithContext(ctx)
+			}
+
+			r = r.WithContext(withStart(r.Context(), start))
+
+			if len(mcfg.headerTags) > 0 {
+				r = r.WithContext(Derive(r.Context(), headerFields(r, mcfg.headerTags)))
+			}
+			if mcfg.acceptLanguage {
+				r = r.WithContext(WithLocale(r.Context(), preferredLocale(r)))
+			}
+			if mcfg.correlationChain {
+				r = r.WithContext(correlationContext(r, mcfg.requestIDHeader))
+			}
+			if mcfg.traceparent {
+				r = r.WithContext(traceparentContext(r))
+			}
+			if mcfg.sourceService {
+				r = r.WithContext(sourceServiceContext(r))
+			}
+			ctx := r.Context()
+
+			// Routers resolving the route while serving the request, such as chi, only provide it afterwards.
+			pattern := route(r)
+			if pattern != "" {
+				r = r.WithContext(WithRoute(ctx, pattern))
+			}
+
+			// Panics are logged as 500 responses when no header was written, and propagated once logged.
+			var panicked any
+			defer func() {
+				if panicked != nil {
+					panic(panicked)
+				}
+			}()
+			defer func() {
+				if panicked = recover(); panicked != nil && !rw.wroteHeader {
+					rw.status = http.StatusInternalServerError
+				}
+
+				elapsed := time.Since(start)
+				if pattern == "" {
+					pattern = route(r)
+				}
+
+				if mcfg.histogram != nil {
+					label := pattern
+					if label == "" {
+						label = unknownRoute
+					}
+					mcfg.histogram.WithLabelValues(label, statusClass(rw.status)).Observe(elapsed.Seconds())
+				}
+
+				level := statusLevel(rw.status)
+				if suppressed(r.URL.Path, mcfg.suppressPaths) {
+					if rw.status >= 200 && rw.status < 300 {
+						return
+					}
+					level = zerolog.WarnLevel
+				}
+
+				// The request is logged without the stored route, so the 'route' field is not written twice by RouteField.
+				e := newEvent(ctx, level).
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Str("url", SanitizeURL(r.URL.RequestURI())).
+					Int("status", rw.status).
+					Float64("duration_ms", float64(elapsed)/float64(time.Millisecond))
+
+				if pattern != "" {
+					e = e.Str("route", pattern)
+				}
+
+				if panicked != nil {
+					e = e.Dict("panic", panicDict(panicked))
+				}
+
+				event(ctx, e).Msg("http request")
+			}()
+
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+func suppressed(path string, paths []string) bool {
+	for _, p := range paths {
+		if path == p || (strings.HasSuffix(p, "/") && strings.HasPrefix(path, p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// headerFields returns a context option adding the request headers as fields, sorted by field name.
+func headerFields(r *http.Request, tags map[string]string) LoggerContextOption {
+	return func(c zerolog.Context) zerolog.Context {
+		names := make([]string, 0, len(tags))
+		for name := range tags {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if value := r.Header.Get(tags[name]); value != "" {
+				c = c.Str(name, truncate(value, maxHeaderTagLength))
+			}
+		}
+		return c
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+// routeFunc returns a function resolving the matched route pattern from the handler, when it is a *http.ServeMux.
+func routeFunc(h http.Handler) func(r *http.Request) string {
+	mux, ok := h.(*http.ServeMux)
+	if !ok {
+		return func(r *http.Request) string { return "" }
+	}
+
+	return func(r *http.Request) string {
+		_, pattern := mux.Handler(r)
+		return pattern
+	}
+}
+
+func statusClass(status int) string {
+	return strconv.Itoa(status/100) + "xx"
+}
+
+func statusLevel(status int) zerolog.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return zerolog.ErrorLevel
+	case status >= http.StatusBadRequest:
+		return zerolog.WarnLevel
+	default:
+		return zerolog.InfoLevel
+	}
+}
+
+// responseWriter captures the status code written by the handler.
+type responseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(p []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(p)
+}
+
+// Unwrap allows http.ResponseController to access the underlying http.ResponseWriter.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,150 @@
+package logger
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	t.Run("HTTPMiddleware when request is served should log the request", func(t *testing.T) {
+		buff.Reset()
+		handler := HTTPMiddleware()(mux)
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42", nil))
+
+		msg := buff.String()
+		assert.Contains(t, msg, "\"level\":\"warn\"")
+		assert.Contains(t, msg, "\"method\":\"GET\"")
+		assert.Contains(t, msg, "\"path\":\"/users/42\"")
+		assert.Contains(t, msg, "\"status\":404")
+		assert.Contains(t, msg, "\"route\":\"GET /users/{id}\"")
+		assert.Contains(t, msg, "\"duration_ms\":")
+	})
+
+	t.Run("HTTPMiddleware when using a latency histogram should observe the request duration by route", func(t *testing.T) {
+		histogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "http_request_duration_seconds"}, []string{"route", "status"})
+		handler := HTTPMiddleware(WithLatencyHistogram(histogram))(mux)
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42", nil))
+
+		metric := &dto.Metric{}
+		observer, err := histogram.GetMetricWithLabelValues("GET /users/{id}", "4xx")
+		assert.NoError(t, err)
+		assert.NoError(t, observer.(prometheus.Histogram).Write(metric))
+		assert.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+		assert.Greater(t, metric.GetHistogram().GetSampleSum(), 0.0)
+		assert.Less(t, metric.GetHistogram().GetSampleSum(), 1.0)
+	})
+
+	t.Run("HTTPMiddleware when using header tags should add the mapped headers to the request logs", func(t *testing.T) {
+		buff.Reset()
+		handler := HTTPMiddleware(WithHeaderTags(map[string]string{
+			"X-Request-ID":     "request_id",
+			"X-Tenant-ID":      "tenant_id",
+			"X-Correlation-ID": "correlation_id",
+		}))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Info(r.Context()).Msg("handling")
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+		req.Header.Set("X-Request-ID", "123")
+		req.Header.Set("X-Tenant-ID", strings.Repeat("t", 300))
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+		assert.Len(t, lines, 2)
+		for _, line := range lines {
+			assert.Contains(t, line, "\"request_id\":\"123\"")
+			assert.Contains(t, line, "\"tenant_id\":\""+strings.Repeat("t", 256)+"\"")
+			assert.NotContains(t, line, "correlation_id")
+		}
+	})
+
+	t.Run("HTTPMiddleware when route is unknown should label the unknown route", func(t *testing.T) {
+		histogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "http_request_duration_seconds"}, []string{"route", "status"})
+		handler := HTTPMiddleware(WithLatencyHistogram(histogram))(http.NotFoundHandler())
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42", nil))
+
+		assert.Equal(t, 1, testutil.CollectAndCount(histogram))
+		assert.True(t, histogram.DeleteLabelValues("unknown", "4xx"))
+	})
+
+	t.Run("HTTPMiddleware when suppressed path succeeds should not log the request", func(t *testing.T) {
+		buff.Reset()
+		handler := HTTPMiddleware(WithSuppressPaths("/healthz", "/probes/"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/probes/ready", nil))
+
+		assert.Empty(t, buff.String())
+	})
+
+	t.Run("HTTPMiddleware when suppressed path fails should log the request as a warning", func(t *testing.T) {
+		buff.Reset()
+		handler := HTTPMiddleware(WithSuppressPaths("/healthz"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+		assert.Contains(t, buff.String(), "\"level\":\"warn\"")
+		assert.Contains(t, buff.String(), "\"status\":503")
+	})
+
+	t.Run("HTTPMiddleware when path only shares the prefix of an exact suppressed path should log the request", func(t *testing.T) {
+		buff.Reset()
+		handler := HTTPMiddleware(WithSuppressPaths("/healthz"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz/deep", nil))
+
+		assert.Contains(t, buff.String(), "\"path\":\"/healthz/deep\"")
+	})
+
+	t.Run("HTTPMiddleware when handler panics before writing should log a 500 and propagate the panic", func(t *testing.T) {
+		buff.Reset()
+		handler := HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic("nil map")
+		}))
+
+		assert.PanicsWithValue(t, "nil map", func() {
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders", nil))
+		})
+
+		assert.Contains(t, buff.String(), "\"level\":\"error\"")
+		assert.Contains(t, buff.String(), "\"status\":500")
+		assert.Contains(t, buff.String(), "\"panic\":{\"type\":\"string\",\"value\":\"nil map\"")
+	})
+
+	t.Run("HTTPMiddleware when handler panics after writing should log the written status", func(t *testing.T) {
+		buff.Reset()
+		handler := HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			panic("late")
+		}))
+
+		assert.Panics(t, func() {
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders", nil))
+		})
+
+		assert.Contains(t, buff.String(), "\"status\":202")
+	})
+}
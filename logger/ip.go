@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"net"
+	"net/netip"
+	"sync/atomic"
+)
+
+var invalidIPs atomic.Uint64
+
+// ipFieldNames are the fields obfuscated by WithIPObfuscation.
+var ipFieldNames = []string{"client_ip", "remote_addr"}
+
+// ObfuscateIP anonymizes an IP address, zeroing the last octet of IPv4 addresses and the last 80 bits of IPv6 addresses.
+// Addresses with a port, such as "203.0.113.42:8080", keep the port. Invalid addresses are returned unchanged and counted by InvalidIPs.
+//
+// Example usage:
+//
+//	logger.ObfuscateIP("203.0.113.42")                 // "203.0.113.0"
+//	logger.ObfuscateIP("2001:db8:85a3::8a2e:370:7334") // "2001:db8:85a3::"
+//
+// Params:
+//
+//	ip (string): The IP address, optionally with a port.
+//
+// Returns:
+//
+//	string: The anonymized IP address.
+func ObfuscateIP(ip string) string {
+	if addr, err := netip.ParseAddr(ip); err == nil {
+		return obfuscateAddr(addr).String()
+	}
+
+	if host, port, err := net.SplitHostPort(ip); err == nil {
+		if addr, err := netip.ParseAddr(host); err == nil {
+			return net.JoinHostPort(obfuscateAddr(addr).String(), port)
+		}
+	}
+
+	invalidIPs.Add(1)
+	return ip
+}
+
+// InvalidIPs returns the number of invalid IP addresses passed to ObfuscateIP since the program started
+// or the last Reset.
+//
+// Returns:
+//
+//	uint64: The number of invalid IP addresses.
+func InvalidIPs() uint64 {
+	return invalidIPs.Load()
+}
+
+func obfuscateAddr(addr netip.Addr) netip.Addr {
+	if addr.Is4() || addr.Is4In6() {
+		b := addr.Unmap().As4()
+		b[3] = 0
+		return netip.AddrFrom4(b)
+	}
+
+	b := addr.As16()
+	clear(b[6:])
+	return netip.AddrFrom16(b)
+}
+
+// WithIPObfuscation anonymizes the 'client_ip' and 'remote_addr' fields of every log event with ObfuscateIP, at write time.
+//
+// Example usage:
+//
+//	cfg.WithIPObfuscation()
+//	logger.Info(ctx).Str("client_ip", "203.0.113.42").Msg("request received") // "client_ip":"203.0.113.0"
+func (cfg *LoggerConfig) WithIPObfuscation() {
+	cfg.recordOptions = append(cfg.recordOptions, func(r *record) {
+		for _, key := range ipFieldNames {
+			if ip, ok := r.str(key); ok {
+				r.set(key, ObfuscateIP(ip))
+			}
+		}
+	})
+}
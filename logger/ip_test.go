@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObfuscateIP(t *testing.T) {
+	suts := map[string]struct {
+		ip       string
+		expected string
+		invalid  uint64
+	}{
+		"ObfuscateIP when address is IPv4 should zero the last octet": {
+			ip:       "203.0.113.42",
+			expected: "203.0.113.0",
+		},
+		"ObfuscateIP when address has a port should keep the port": {
+			ip:       "203.0.113.42:8080",
+			expected: "203.0.113.0:8080",
+		},
+		"ObfuscateIP when address is IPv6 should zero the last 80 bits": {
+			ip:       "2001:db8:85a3:8d3:1319:8a2e:370:7348",
+			expected: "2001:db8:85a3::",
+		},
+		"ObfuscateIP when address is malformed should return it unchanged and count it": {
+			ip:       "not-an-ip",
+			expected: "not-an-ip",
+			invalid:  1,
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			before := InvalidIPs()
+
+			assert.Equal(t, sut.expected, ObfuscateIP(sut.ip))
+			assert.Equal(t, sut.invalid, InvalidIPs()-before)
+		})
+	}
+}
+
+func TestWithIPObfuscation(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithIPObfuscation()
+	})
+
+	Info(context.TODO()).Str("client_ip", "203.0.113.42").Str("remote_addr", "[2001:db8::1]:443").Str("server_ip", "10.0.0.1").Msg("request")
+
+	msg := buff.String()
+	assert.Contains(t, msg, "\"client_ip\":\"203.0.113.0\"")
+	assert.Contains(t, msg, "\"remote_addr\":\"[2001:db8::]:443\"")
+	assert.Contains(t, msg, "\"server_ip\":\"10.0.0.1\"")
+	assert.Contains(t, msg, "\"message\":\"request\"}\n")
+}
@@ -0,0 +1,96 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+type jobCtxKey struct{}
+
+type job struct {
+	name        string
+	id          string
+	attempt     int
+	maxAttempts int
+}
+
+// WithJob stores the background job execution details into the context,
+// so they are written by the JobFields event option on every log event created with the returned context.
+//
+// Example usage:
+//
+//	ctx = logger.WithJob(ctx, "send-invoices", jobID, attempt)
+//	logger.Info(ctx).Msg("job started") // Includes 'job_name', 'job_id' and 'attempt' fields.
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the job details are stored.
+//	name (string): The name of the job.
+//	id (string): The identifier of the job execution.
+//	attempt (int): The current attempt of the job execution.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the job details.
+func WithJob(ctx context.Context, name, id string, attempt int) context.Context {
+	j := job{name: name, id: id, attempt: attempt}
+	if parent, ok := ctx.Value(jobCtxKey{}).(job); ok {
+		j.maxAttempts = parent.maxAttempts
+	}
+	return context.WithValue(ctx, jobCtxKey{}, j)
+}
+
+// WithJobMaxAttempts sets the maximum number of attempts of the job stored in the context,
+// so the JobFields event option marks the last one with the 'final_attempt' field.
+// It has no effect if the context does not carry a job.
+//
+// Example usage:
+//
+//	ctx = logger.WithJobMaxAttempts(logger.WithJob(ctx, "send-invoices", jobID, 3), 3)
+//	logger.Info(ctx).Msg("job started") // Includes the 'final_attempt' field.
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the job details.
+//	maxAttempts (int): The maximum number of attempts of the job.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the job details.
+func WithJobMaxAttempts(ctx context.Context, maxAttempts int) context.Context {
+	j, ok := ctx.Value(jobCtxKey{}).(job)
+	if !ok {
+		return ctx
+	}
+	j.maxAttempts = maxAttempts
+	return context.WithValue(ctx, jobCtxKey{}, j)
+}
+
+// JobFields returns an event option that writes the job details stored in the context by WithJob
+// as the 'job_name', 'job_id' and 'attempt' fields, and 'final_attempt' on the last attempt.
+// Log events created with a context without a job are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.JobFields())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the job fields.
+func JobFields() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		j, ok := ctx.Value(jobCtxKey{}).(job)
+		if !ok {
+			return e
+		}
+
+		e = e.Str("job_name", j.name).Str("job_id", j.id).Int("attempt", j.attempt)
+
+		if j.maxAttempts > 0 && j.attempt >= j.maxAttempts {
+			e = e.Bool("final_attempt", true)
+		}
+
+		return e
+	}
+}
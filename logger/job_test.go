@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobFields(t *testing.T) {
+	suts := map[string]struct {
+		ctx    func() context.Context
+		assert func(t *testing.T, msg string)
+	}{
+		"JobFields when context carries a job should write the job fields": {
+			ctx: func() context.Context {
+				return WithJob(context.TODO(), "send-invoices", "42", 1)
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"job_name\":\"send-invoices\"")
+				assert.Contains(t, msg, "\"job_id\":\"42\"")
+				assert.Contains(t, msg, "\"attempt\":1")
+				assert.NotContains(t, msg, "final_attempt")
+			},
+		},
+		"JobFields when attempt is not the last should not mark the final attempt": {
+			ctx: func() context.Context {
+				return WithJobMaxAttempts(WithJob(context.TODO(), "send-invoices", "42", 2), 3)
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"attempt\":2")
+				assert.NotContains(t, msg, "final_attempt")
+			},
+		},
+		"JobFields when attempt is the last should mark the final attempt": {
+			ctx: func() context.Context {
+				ctx := WithJobMaxAttempts(WithJob(context.TODO(), "send-invoices", "42", 1), 3)
+				return WithJob(ctx, "send-invoices", "42", 3)
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"attempt\":3")
+				assert.Contains(t, msg, "\"final_attempt\":true")
+			},
+		},
+		"JobFields when context does not carry a job should not write job fields": {
+			ctx: context.TODO,
+			assert: func(t *testing.T, msg string) {
+				assert.NotContains(t, msg, "job_name")
+				assert.NotContains(t, msg, "attempt")
+			},
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithEventFields(JobFields())
+			})
+
+			Info(sut.ctx()).Msg("job log")
+
+			sut.assert(t, buff.String())
+		})
+	}
+}
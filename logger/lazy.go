@@ -0,0 +1,28 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+// WithLazyEventFields adds the fields returned by fn to each log event, calling fn only if the event is going to be written.
+// This avoids the cost of computing expensive fields for events filtered out by level or sampling.
+//
+// Example usage:
+//
+//	cfg.WithLazyEventFields(func(ctx context.Context) map[string]any {
+//	    return map[string]any{"cart": expensiveCartSummary(ctx)}
+//	})
+//
+// Params:
+//
+//	fn (func(ctx context.Context) map[string]any): The function computing the fields of each log event.
+func (cfg *LoggerConfig) WithLazyEventFields(fn func(ctx context.Context) map[string]any) {
+	cfg.WithEventFields(func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		if !e.Enabled() {
+			return e
+		}
+		return e.Fields(fn(ctx))
+	})
+}
@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithLazyEventFields(t *testing.T) {
+	calls := 0
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithLevel(zerolog.InfoLevel)
+		cfg.WithLazyEventFields(func(ctx context.Context) map[string]any {
+			calls++
+			return map[string]any{"lazy": "value"}
+		})
+	})
+
+	t.Run("WithLazyEventFields when event is enabled should write the fields", func(t *testing.T) {
+		Info(context.TODO()).Msg("enabled")
+		assert.Contains(t, buff.String(), "\"lazy\":\"value\"")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("WithLazyEventFields when event is disabled should not call the function", func(t *testing.T) {
+		Debug(context.TODO()).Msg("disabled")
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func BenchmarkWithLazyEventFields(b *testing.B) {
+	calls := 0
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(io.Discard)
+		cfg.WithLevel(zerolog.InfoLevel)
+		cfg.WithLazyEventFields(func(ctx context.Context) map[string]any {
+			calls++
+			return map[string]any{"lazy": "value"}
+		})
+	})
+	ctx := context.TODO()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Debug(ctx).Msg("disabled")
+	}
+
+	if calls != 0 {
+		b.Fatalf("lazy fields computed %d times for a disabled level", calls)
+	}
+}
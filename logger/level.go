@@ -0,0 +1,80 @@
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+var currentLevel atomic.Int32
+
+// SetLevel changes, at runtime, the minimum level of the log events written by the logger and the loggers stored in contexts.
+// The level set by WithLevel is restored on Configure.
+//
+// Example usage:
+//
+//	logger.SetLevel(zerolog.DebugLevel) // Enables debug events while troubleshooting.
+//
+// Params:
+//
+//	level (zerolog.Level): The minimum level to be written.
+func SetLevel(level zerolog.Level) {
+	currentLevel.Store(int32(level))
+}
+
+// GetLevel returns the current minimum level of the log events written by the logger.
+//
+// Returns:
+//
+//	zerolog.Level: The current minimum level.
+func GetLevel() zerolog.Level {
+	return zerolog.Level(currentLevel.Load())
+}
+
+type levelPayload struct {
+	Level string `json:"level"`
+}
+
+// LevelHTTPHandler returns a handler to read and change the logger level at runtime.
+// GET responds with the current level as JSON, such as {"level":"info"}.
+// PUT and POST change the level with SetLevel, reading the same JSON from the request body,
+// responding with the new level or with 400 Bad Request when the level is not valid.
+//
+// Example usage:
+//
+//	mux.Handle("/log/level", logger.LevelHTTPHandler())
+//	// curl -X PUT -d '{"level":"debug"}' localhost:8080/log/level
+//
+// Returns:
+//
+//	http.Handler: The handler of the logger level.
+func LevelHTTPHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var payload levelPayload
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				http.Error(w, "invalid payload: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			level, err := zerolog.ParseLevel(payload.Level)
+			if err != nil || payload.Level == "" {
+				http.Error(w, "invalid level: "+payload.Level, http.StatusBadRequest)
+				return
+			}
+
+			SetLevel(level)
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(levelPayload{Level: GetLevel().String()})
+	})
+}
@@ -0,0 +1,92 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLevel(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithLevel(zerolog.InfoLevel)
+	})
+	ctx := WithContext(context.TODO())
+
+	t.Run("SetLevel when level is lowered should write the enabled events", func(t *testing.T) {
+		buff.Reset()
+		SetLevel(zerolog.DebugLevel)
+
+		Debug(ctx).Msg("debug message")
+
+		assert.Contains(t, buff.String(), "\"message\":\"debug message\"")
+	})
+
+	t.Run("SetLevel when level is raised should discard the disabled events", func(t *testing.T) {
+		buff.Reset()
+		SetLevel(zerolog.ErrorLevel)
+
+		Warn(ctx).Msg("warn message")
+
+		assert.Empty(t, buff.String())
+	})
+}
+
+func TestLevelHTTPHandler(t *testing.T) {
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithLevel(zerolog.InfoLevel)
+	})
+	handler := LevelHTTPHandler()
+
+	suts := map[string]struct {
+		method string
+		body   string
+		status int
+		level  zerolog.Level
+	}{
+		"LevelHTTPHandler when method is GET should respond with the current level": {
+			method: http.MethodGet,
+			status: http.StatusOK,
+			level:  zerolog.InfoLevel,
+		},
+		"LevelHTTPHandler when method is PUT should change the level": {
+			method: http.MethodPut,
+			body:   `{"level":"debug"}`,
+			status: http.StatusOK,
+			level:  zerolog.DebugLevel,
+		},
+		"LevelHTTPHandler when level is invalid should respond with bad request": {
+			method: http.MethodPut,
+			body:   `{"level":"verbose"}`,
+			status: http.StatusBadRequest,
+			level:  zerolog.InfoLevel,
+		},
+		"LevelHTTPHandler when method is not supported should respond with method not allowed": {
+			method: http.MethodDelete,
+			status: http.StatusMethodNotAllowed,
+			level:  zerolog.InfoLevel,
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			SetLevel(zerolog.InfoLevel)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, httptest.NewRequest(sut.method, "/log/level", strings.NewReader(sut.body)))
+
+			assert.Equal(t, sut.status, rec.Code)
+			assert.Equal(t, sut.level, GetLevel())
+			if sut.status == http.StatusOK {
+				assert.JSONEq(t, `{"level":"`+sut.level.String()+`"}`, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"errors"
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+type levelRange struct {
+	min zerolog.Level
+	max zerolog.Level
+	w   io.Writer
+}
+
+// WithWriterForLevelRange registers an output destination receiving only the log events with a level between min and max, inclusive.
+// Once a level range writer is registered, log events are written only to the writers whose range includes the event level,
+// replacing the writer set by WithWriter. Events matching overlapping ranges are written to all of them.
+//
+// Example usage:
+//
+//	cfg.WithWriterForLevelRange(zerolog.TraceLevel, zerolog.DebugLevel, debugFile)
+//	cfg.WithWriterForLevelRange(zerolog.InfoLevel, zerolog.WarnLevel, statsSink)
+//	cfg.WithWriterForLevelRange(zerolog.ErrorLevel, zerolog.PanicLevel, alertsSink)
+//
+// Params:
+//
+//	min (zerolog.Level): The lowest level written to w.
+//	max (zerolog.Level): The highest level written to w.
+//	w (io.Writer): The output destination for the log events within the range.
+func (cfg *LoggerConfig) WithWriterForLevelRange(min, max zerolog.Level, w io.Writer) {
+	cfg.levelWriters = append(cfg.levelWriters, levelRange{min: min, max: max, w: w})
+}
+
+func (cfg *LoggerConfig) levelRangeWriter(format Format) *levelRangeWriter {
+	ranges := make([]levelRange, len(cfg.levelWriters))
+	for i, r := range cfg.levelWriters {
+		ranges[i] = levelRange{min: r.min, max: r.max, w: cfg.encoder(r.w, format)}
+	}
+	return &levelRangeWriter{ranges: ranges}
+}
+
+// levelRangeWriter dispatches each log event to the writers whose range includes the event level.
+type levelRangeWriter struct {
+	ranges []levelRange
+}
+
+// Write writes p to every writer, since the level of p is unknown.
+func (w *levelRangeWriter) Write(p []byte) (int, error) {
+	var err error
+	for _, r := range w.ranges {
+		if _, wErr := r.w.Write(p); wErr != nil && err == nil {
+			err = wErr
+		}
+	}
+	return len(p), err
+}
+
+func (w *levelRangeWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	var err error
+	for _, r := range w.ranges {
+		if level < r.min || level > r.max {
+			continue
+		}
+		if _, wErr := writeLevel(r.w, level, p); wErr != nil && err == nil {
+			err = wErr
+		}
+	}
+	return len(p), err
+}
+
+// Sync flushes every writer supporting it.
+func (w *levelRangeWriter) Sync() error {
+	errs := make([]error, len(w.ranges))
+	for i, r := range w.ranges {
+		errs[i] = syncWriter(r.w)
+	}
+	return errors.Join(errs...)
+}
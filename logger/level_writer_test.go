@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithWriterForLevelRange(t *testing.T) {
+	debug, stats, alerts, all := &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriterForLevelRange(zerolog.TraceLevel, zerolog.DebugLevel, debug)
+		cfg.WithWriterForLevelRange(zerolog.InfoLevel, zerolog.WarnLevel, stats)
+		cfg.WithWriterForLevelRange(zerolog.ErrorLevel, zerolog.PanicLevel, alerts)
+	})
+
+	t.Run("Warn when Msg is invoked should write only to the info-warn range", func(t *testing.T) {
+		Warn(context.TODO()).Msg("warn message")
+
+		assert.Empty(t, debug.String())
+		assert.Contains(t, stats.String(), "\"message\":\"warn message\"")
+		assert.Empty(t, alerts.String())
+	})
+
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriterForLevelRange(zerolog.InfoLevel, zerolog.WarnLevel, stats)
+		cfg.WithWriterForLevelRange(zerolog.TraceLevel, zerolog.PanicLevel, all)
+	})
+
+	t.Run("Info when ranges overlap should write to all matching ranges", func(t *testing.T) {
+		stats.Reset()
+
+		Info(context.TODO()).Msg("info message")
+
+		assert.Contains(t, stats.String(), "\"message\":\"info message\"")
+		assert.Contains(t, all.String(), "\"message\":\"info message\"")
+	})
+}
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+type localeCtxKey struct{}
+
+// WithLocale stores the request locale into the context, written by the LocaleField event option as the 'locale' field
+// on every log event created with the returned context. Since the locale is read from the context rather than from
+// the context logger, it is kept when WithContext or Derive are called afterwards.
+// The locale is written verbatim, even when malformed, to help debugging localization issues.
+// An empty locale does not change the context.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.LocaleField())
+//	ctx = logger.WithLocale(ctx, "pt-BR")
+//	logger.Info(ctx).Msg("invoice rendered") // "locale":"pt-BR"
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the locale is stored.
+//	locale (string): The locale of the request.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the locale.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	if locale == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, localeCtxKey{}, locale)
+}
+
+// LocaleField returns an event option that writes the locale stored into the context by WithLocale as the 'locale' field.
+// Log events created with a context without a locale are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.LocaleField())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the locale field.
+func LocaleField() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		if locale, ok := ctx.Value(localeCtxKey{}).(string); ok {
+			return e.Str("locale", locale)
+		}
+		return e
+	}
+}
+
+// WithAcceptLanguage makes the HTTP middleware store the preferred locale of the 'Accept-Language' request header
+// into the request context with WithLocale, such as "pt-BR" for "pt-BR,pt;q=0.9,en;q=0.8".
+// The locale is written as the 'locale' field by the LocaleField event option.
+//
+// Example usage:
+//
+//	handler := logger.HTTPMiddleware(logger.WithAcceptLanguage())(mux)
+//
+// Returns:
+//
+//	HTTPMiddlewareOption: The option to be passed to HTTPMiddleware.
+func WithAcceptLanguage() HTTPMiddlewareOption {
+	return func(cfg *httpMiddlewareConfig) {
+		cfg.acceptLanguage = true
+	}
+}
+
+// preferredLocale returns the first language range of the 'Accept-Language' header, without its quality value.
+func preferredLocale(r *http.Request) string {
+	locale, _, _ := strings.Cut(r.Header.Get("Accept-Language"), ",")
+	locale, _, _ = strings.Cut(locale, ";")
+	return truncate(strings.TrimSpace(locale), maxHeaderTagLength)
+}
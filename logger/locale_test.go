@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithLocale(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(LocaleField())
+	})
+
+	suts := map[string]struct {
+		ctx    context.Context
+		locale string
+	}{
+		"WithLocale when context carries the locale should write the locale field": {ctx: WithLocale(context.TODO(), "pt-BR"), locale: "\"locale\":\"pt-BR\""},
+		"WithLocale when locale is malformed should write it verbatim":             {ctx: WithLocale(context.TODO(), "not a locale!"), locale: "\"locale\":\"not a locale!\""},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff.Reset()
+
+			Info(sut.ctx).Msg("localized")
+			Info(WithContext(sut.ctx)).Msg("localized")
+
+			assert.Equal(t, 2, strings.Count(buff.String(), sut.locale))
+		})
+	}
+
+	t.Run("WithLocale when context does not carry the locale should not write the locale field", func(t *testing.T) {
+		buff.Reset()
+
+		Info(WithLocale(context.TODO(), "")).Msg("localized")
+
+		assert.NotContains(t, buff.String(), "locale")
+	})
+
+	t.Run("HTTPMiddleware when using accept language should store the preferred locale", func(t *testing.T) {
+		buff.Reset()
+		handler := HTTPMiddleware(WithAcceptLanguage())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Info(r.Context()).Msg("localized")
+		}))
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Language", "pt-BR,pt;q=0.9,en;q=0.8")
+
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+
+		assert.Equal(t, 2, strings.Count(buff.String(), "\"locale\":\"pt-BR\""))
+	})
+}
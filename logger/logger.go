@@ -4,22 +4,109 @@ import (
 	"context"
 	"io"
 	"os"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/rs/zerolog"
 )
 
-var logger zerolog.Logger = CreateLoggerContext(os.Stdout).Logger()
+// current is the configuration in use, along with the global logger built from it, published atomically
+// by Configure and Reset so they can be called while logging.
+var current atomic.Pointer[LoggerConfig]
 
-var cfg *LoggerConfig = &LoggerConfig{
-	ctxFields:   []LoggerContextOption{},
-	eventFields: []LogEventOption{},
+// configureMu serializes Configure and Reset.
+var configureMu sync.Mutex
+
+func init() {
+	cfg := newLoggerConfig()
+	cfg.root = cfg.logger()
+	current.Store(cfg)
+
+	// Set once, so the timestamp function follows the configuration in use without writing the zerolog global again.
+	zerolog.TimestampFunc = func() time.Time {
+		return config().timestampFunc()
+	}
 }
 
+// config returns the configuration in use.
+func config() *LoggerConfig {
+	return current.Load()
+}
+
+// Format represents the encoding used to render log events.
+type Format int
+
+const (
+	FormatJSON    Format = iota // Newline-delimited JSON, suitable for log pipelines.
+	FormatConsole               // Human-friendly, colorized output, suitable for local development.
+)
+
 // LoggerConfig holds configurations for the logger, including context and event modifiers.
 type LoggerConfig struct {
-	ctxFields   []LoggerContextOption // Context modifiers to add additional contextual information to each log.
-	eventFields []LogEventOption      // Event modifiers to customize log events on-the-fly.
-	w           io.Writer             // Writer for log events
+	ctxFields      []LoggerContextOption // Context modifiers to add additional contextual information to each log.
+	eventFields    []LogEventOption      // Event modifiers to customize log events on-the-fly.
+	w              io.Writer             // Writer for log events
+	level          zerolog.Level         // Minimum level of the log events to be written.
+	format         Format                // Encoding used to render log events.
+	timestampFunc  func() time.Time      // Function used to generate the timestamp of each log event.
+	exitFunc       func(code int)        // Function called to terminate the program after a fatal event.
+	hooks          []zerolog.Hook        // Hooks run right before each log event is written.
+	levelWriters   []levelRange          // Writers receiving only the log events within a range of levels.
+	levelResolvers []levelResolver       // Functions changing the level of log events before they are created.
+	errFields      []errorEventOption    // Event modifiers applied by Err when an error is attached.
+	writerOptions  []writerOption        // Writer modifiers processing the rendered log events before they are encoded.
+	gzipFile       *gzipFile             // Compressed file used as output destination, replacing the writer.
+	batch          *batchConfig          // Batching writer used as output destination, replacing the writer.
+	flushInterval  time.Duration         // Interval at which buffered writers are flushed.
+	out            io.Writer             // Writer chain built from the configuration.
+	root           zerolog.Logger        // Global logger built from the configuration.
+	base           io.Writer             // Output destination shared by the writer chains.
+	formatOuts     map[Format]io.Writer  // Writer chains of the formats set by WithFormat, built on first use.
+	mu             sync.Mutex            // Guards the writer chains built on first use and the writers registered by them.
+	raw            io.Writer             // Output destination of the writer chain, written by Raw.
+	closers        []io.Closer           // Writers closed on Shutdown.
+	pooling        bool                  // Whether intermediate structures are reused across log events.
+	samplers       levelSampler          // Samplers of the log events, by level.
+	recordOptions  []recordOption        // Modifiers of the rendered log events, applied at write time.
+	prefixAsEnv    bool                  // Whether the message prefix is written as the 'env' field in FormatJSON.
+	traceWriters   *traceWriters         // Writers receiving the log events of sampled and unsampled traces, replacing the writer.
+	colorizedJSON  bool                  // Whether the level of FormatJSON log events written to terminals is colorized.
+	expectedErrors []expectedErrors      // Errors logged by Err below the "error" level.
+	beforeExit     []func()              // Callbacks run after a fatal event is written, before exiting.
+	consoleExclude []string              // Fields hidden from the FormatConsole output.
+	consoleParts   []string              // Order of the parts of the FormatConsole output.
+	errCallbacks   []errorCallback       // Callbacks invoked for each error logged at the "error" level or above.
+	customEncoder  Encoder               // Encoder rendering log events, replacing the format.
+	breaker        *breakerWriter        // Circuit breaker of the writer, queried by WriterBreakerState.
+	dropCallbacks  []DropCallback        // Callbacks invoked for each discarded log event.
+	optionTimeout  time.Duration         // Maximum duration of each event option, or zero to run them without a watchdog.
+	contextErrors  bool                  // Whether the errors stored in the context by WithError are attached to error events.
+	spanErrors     bool                  // Whether the errors attached by Err are recorded on the span once the event is written.
+	baggageLevel   string                // Baggage member overriding the level of the log events of a context.
+	envelope       recordOption          // Modifier wrapping the rendered log events, applied by each output destination once they are routed.
+	flushers       []flusher             // Writers holding log events in memory, flushed by WithFlushOnError.
+	flushOnError   bool                  // Whether the writers holding log events in memory are flushed after each error event.
+	eventMisuse    bool                  // Whether events sent from a goroutine other than the one creating them panic.
+	dualOutput     string                // File receiving JSON log events while os.Stdout receives FormatConsole ones.
+	requestBuffer  *requestBufferConfig  // Holding of the log events of each request by the HTTP middleware.
+	block          *blockLock            // Lock of the output destinations, held while the log events of a request are written.
+	fieldRoutes    []fieldRoute          // Writers receiving the log events by the value of one of their fields.
+	callers        bool                  // Whether the frame that created each log event is resolved for the event options.
+}
+
+func newLoggerConfig() *LoggerConfig {
+	return &LoggerConfig{
+		ctxFields:     []LoggerContextOption{},
+		eventFields:   []LogEventOption{},
+		w:             os.Stdout,
+		level:         zerolog.TraceLevel,
+		format:        FormatJSON,
+		timestampFunc: time.Now,
+		exitFunc:      os.Exit,
+		hooks:         []zerolog.Hook{},
+		flushInterval: time.Second,
+	}
 }
 
 // WithContextFields adds a context modifier that includes additional default fields to the logger context.
@@ -70,15 +157,137 @@ func (cfg *LoggerConfig) WithWriter(w io.Writer) {
 	cfg.w = w
 }
 
+// WithLevel sets the minimum level of the log events written by the logger.
+// Events below the given level are discarded.
+//
+// Example usage:
+//
+//	cfg.WithLevel(zerolog.InfoLevel) // Discards debug and trace events.
+//
+// Params:
+//
+//	level (zerolog.Level): The minimum level to be written.
+func (cfg *LoggerConfig) WithLevel(level zerolog.Level) {
+	cfg.level = level
+}
+
+// WithFormat sets the encoding used to render log events.
+// The default format is FormatJSON.
+//
+// Example usage:
+//
+//	cfg.WithFormat(logger.FormatConsole) // Human-friendly output for local development.
+//
+// Params:
+//
+//	f (Format): The encoding used to render log events.
+func (cfg *LoggerConfig) WithFormat(f Format) {
+	cfg.format = f
+}
+
+// WithConsoleExcludeFields hides the given fields from the FormatConsole output, such as noisy identifiers
+// that clutter the line during local development. FormatJSON output is not changed.
+//
+// Example usage:
+//
+//	cfg.WithConsoleExcludeFields("trace_id", "span_id", "pid")
+//
+// Params:
+//
+//	keys (...string): The keys of the hidden fields.
+func (cfg *LoggerConfig) WithConsoleExcludeFields(keys ...string) {
+	cfg.consoleExclude = append(cfg.consoleExclude, keys...)
+}
+
+// WithConsolePartsOrder sets the order of the parts of the FormatConsole output, among the "time", "level", "caller"
+// and "message" parts, which are named after the zerolog field names. Parts left out are not written, and unknown parts are ignored.
+// FormatJSON output is not changed.
+//
+// Example usage:
+//
+//	cfg.WithConsolePartsOrder([]string{"level", "time", "message"}) // INF 12:00PM order created
+//
+// Params:
+//
+//	order ([]string): The names of the parts, in order.
+func (cfg *LoggerConfig) WithConsolePartsOrder(order []string) {
+	known := map[string]bool{
+		zerolog.TimestampFieldName: true,
+		zerolog.LevelFieldName:     true,
+		zerolog.CallerFieldName:    true,
+		zerolog.MessageFieldName:   true,
+	}
+
+	cfg.consoleParts = nil
+	for _, part := range order {
+		if known[part] {
+			cfg.consoleParts = append(cfg.consoleParts, part)
+		}
+	}
+}
+
+// WithUTC forces the timestamp of every log event to be generated in UTC,
+// avoiding mixed-timezone timestamps across replicas.
+// Since zerolog generates timestamps through the global zerolog.TimestampFunc, it is replaced on Configure.
+//
+// Example usage:
+//
+//	cfg.WithUTC() // "time":"2024-05-01T12:00:00Z"
+func (cfg *LoggerConfig) WithUTC() {
+	cfg.timestampFunc = func() time.Time {
+		return time.Now().UTC()
+	}
+}
+
 // LoggerOption represents a function that modifies LoggerConfig.
 type LoggerOption func(cfg *LoggerConfig)
 
+// errorCallback represents a function invoked with the error and message of an error log event.
+type errorCallback func(ctx context.Context, err error, msg string)
+
+// levelResolver represents a function that changes the level of a log event based on its context.
+// It also returns a modifier of the log event, or nil, so the fields explaining the level are written
+// from the same evaluation of the context.
+type levelResolver func(ctx context.Context, level zerolog.Level) (zerolog.Level, func(e *zerolog.Event) *zerolog.Event)
+
+// errorEventOption represents a function that modifies a logging event based on the error attached to it.
+type errorEventOption func(ctx context.Context, e *zerolog.Event, err error) *zerolog.Event
+
+// writerOption represents a function that wraps the writer of JSON rendered log events.
+// Writer options are applied in order, so the last one registered is the first to receive each log event.
+type writerOption func(w zerolog.LevelWriter) zerolog.LevelWriter
+
 // LoggerContextOption represents a function that modifies zerolog.Context for additional contextual logging setup.
 type LoggerContextOption func(c zerolog.Context) zerolog.Context
 
 // LogEventOption represents a function that modifies a logging event, allowing dynamic changes to the log output.
 type LogEventOption func(ctx context.Context, e *zerolog.Event) *zerolog.Event
 
+// RecommendProductionDefaults returns an option bundle with the recommended settings for production environments:
+// JSON format, UTC timestamps, "info" level and os.Stdout as output.
+// Options passed after the bundle to Configure override its defaults.
+//
+// Example usage:
+//
+//	logger.Configure(
+//		logger.RecommendProductionDefaults(),
+//		func(cfg *logger.LoggerConfig) {
+//			cfg.WithLevel(zerolog.WarnLevel) // Overrides the "info" level from the bundle.
+//		},
+//	)
+//
+// Returns:
+//
+//	LoggerOption: The option bundle to be passed to Configure.
+func RecommendProductionDefaults() LoggerOption {
+	return func(cfg *LoggerConfig) {
+		cfg.WithFormat(FormatJSON)
+		cfg.WithUTC()
+		cfg.WithLevel(zerolog.InfoLevel)
+		cfg.WithWriter(os.Stdout)
+	}
+}
+
 // CreateLoggerContext initializes a zerolog.Context with standard fields and applies any provided LoggerContextOptions.
 // This function is typically used to set up a base logger from which all other loggers inherit.
 //
@@ -111,6 +320,7 @@ func CreateLoggerContext(w io.Writer, opts ...LoggerContextOption) zerolog.Conte
 
 // Configure configures the global logger with specified LoggerOptions which can modify both context and event behaviors.
 // This function initializes the logger configuration and applies the options to set up context and event modifiers.
+// The writers opened by the previous configuration, such as compressed files, are flushed and closed.
 //
 // Example usage:
 //
@@ -133,18 +343,152 @@ func CreateLoggerContext(w io.Writer, opts ...LoggerContextOption) zerolog.Conte
 //
 //	zerolog.Logger: The configured logger instance.
 func Configure(opts ...LoggerOption) zerolog.Logger {
-	cfg = &LoggerConfig{
-		ctxFields:   []LoggerContextOption{},
-		eventFields: []LogEventOption{},
-	}
+	configureMu.Lock()
+	defer configureMu.Unlock()
+
+	return configure(opts...)
+}
+
+func configure(opts ...LoggerOption) zerolog.Logger {
+	cfg := newLoggerConfig()
 
 	for _, opt := range opts {
 		opt(cfg)
 	}
 
-	logger = CreateLoggerContext(cfg.w, cfg.ctxFields...).Logger()
+	pooling.Store(cfg.pooling)
+
+	// The configuration is only published once built, so the log events never see it partially built.
+	cfg.root = cfg.logger()
+	prev := current.Swap(cfg)
+
+	// The writers opened by the previous configuration, such as compressed files, are flushed and closed once replaced.
+	_ = prev.shutdown(context.Background())
+
+	return cfg.root
+}
+
+func (cfg *LoggerConfig) logger() zerolog.Logger {
+	cfg.out = cfg.writer()
+
+	SetLevel(cfg.level)
+
+	// The level is enforced by the sampler, so it can be changed at runtime by SetLevel.
+	return CreateLoggerContext(cfg.out, cfg.ctxFields...).Logger().Hook(cfg.loggerHooks()...).Sample(levelGate{samplers: cfg.samplers})
+}
+
+// loggerHooks returns the configured hooks, preceded by the level hook and followed by the other internal ones.
+func (cfg *LoggerConfig) loggerHooks() []zerolog.Hook {
+	hooks := append(append([]zerolog.Hook{levelHook}, cfg.hooks...), exitCodeHook)
+	if cfg.traceRouting() {
+		hooks = append(hooks, traceSampledHook)
+	}
+	return hooks
+}
+
+// writer builds the output destination shared by the writer chains and returns the writer chain of the configured format.
+func (cfg *LoggerConfig) writer() io.Writer {
+	var w io.Writer = cfg.w
+
+	if cfg.dualOutput != "" {
+		f := &fileWriter{path: cfg.dualOutput}
+		cfg.closers = append(cfg.closers, f)
+		w = f
+	}
+
+	if cfg.gzipFile != nil {
+		gz := newGzipFileWriter(cfg.gzipFile.path, cfg.gzipFile.level, cfg.flushInterval)
+		cfg.closers = append(cfg.closers, gz)
+		w = gz
+	}
+
+	if cfg.batch != nil {
+		bw := newBatchWriter(cfg.batch)
+		cfg.closers = append(cfg.closers, bw)
+		cfg.flushers = append(cfg.flushers, bw)
+		w = bw
+	}
+
+	if cfg.requestBuffer != nil {
+		cfg.block = &blockLock{}
+	}
+
+	cfg.base = w
+	cfg.raw = cfg.blocked(w)
+
+	if cfg.dualOutput != "" {
+		cfg.raw = cfg.blocked(io.MultiWriter(w, os.Stdout))
+	}
+
+	return cfg.chain(formatDefault)
+}
+
+// chain returns the writer chain rendering log events with the format into the output destination.
+func (cfg *LoggerConfig) chain(format Format) io.Writer {
+	var lw zerolog.LevelWriter
+	if cfg.traceRouting() {
+		// The log events are routed before the record options, so they never see the sampled flag.
+		lw = &traceRoutedWriter{
+			sampled:   cfg.records(cfg.encoder(cfg.traceWriters.sampled, format), format),
+			unsampled: cfg.records(cfg.encoder(cfg.traceWriters.unsampled, format), format),
+		}
+	} else {
+		lw = cfg.records(cfg.sink(format), format)
+	}
+
+	for _, opt := range cfg.writerOptions {
+		lw = opt(lw)
+	}
+
+	// Resolves the context error before the writer options, so they see the same 'error' field as the output.
+	lw = &contextErrorWriter{w: lw}
+
+	if cfg.flushOnError && len(cfg.flushers) > 0 {
+		lw = &flushOnErrorWriter{w: lw, flushers: cfg.flushers}
+	}
+
+	return newExitWriter(lw, func(code int) {
+		cfg.runBeforeExit(lw)
+		_ = cfg.shutdown(context.Background())
+		cfg.exitFunc(code)
+	})
+}
 
-	return logger
+// sink returns the writer rendering log events with the format into the configured output destinations.
+func (cfg *LoggerConfig) sink(format Format) io.Writer {
+	if len(cfg.levelWriters) > 0 {
+		return cfg.levelRangeWriter(format)
+	}
+	if cfg.dualOutput != "" {
+		return cfg.dualOutputWriter(format)
+	}
+	return cfg.encoder(cfg.base, format)
+}
+
+// records returns the writer applying the record options and the field routes to the log events written to w.
+func (cfg *LoggerConfig) records(w io.Writer, format Format) zerolog.LevelWriter {
+	if len(cfg.fieldRoutes) > 0 {
+		w = cfg.fieldRoutedWriter(w, format)
+	}
+
+	lw := levelWriter(w)
+	if len(cfg.recordOptions) > 0 {
+		lw = &recordWriter{w: lw, opts: cfg.recordOptions}
+	}
+	return lw
+}
+
+func (cfg *LoggerConfig) formatEncoder(w io.Writer) io.Writer {
+	if cfg.customEncoder != nil {
+		return &encoderWriter{w: w, enc: cfg.customEncoder}
+	}
+	if cfg.format == FormatConsole {
+		return cfg.consoleWriter(w)
+	}
+	if cfg.colorizedJSON && colorize(w) {
+		return &colorJSONWriter{w: w}
+	}
+	return w
 }
 
 // Info starts a new logging event at the "info" level.
@@ -163,7 +507,7 @@ func Configure(opts ...LoggerOption) zerolog.Logger {
 //
 //	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
 func Info(ctx context.Context) *zerolog.Event {
-	e := logger.Info().Ctx(ctx)
+	e := newEvent(ctx, zerolog.InfoLevel)
 
 	return event(ctx, e)
 }
@@ -184,7 +528,7 @@ func Info(ctx context.Context) *zerolog.Event {
 //
 //	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
 func Warn(ctx context.Context) *zerolog.Event {
-	e := logger.Warn().Ctx(ctx)
+	e := newEvent(ctx, zerolog.WarnLevel)
 
 	return event(ctx, e)
 }
@@ -192,7 +536,7 @@ func Warn(ctx context.Context) *zerolog.Event {
 // Err initializes a new logging event at the "error" level with err as field if not nil or with "info" level if err is nil.
 // This function requires a context.Context to extract necessary tracing information
 // and an error which will be logged. It returns a *zerolog.Event that is not sent
-// until the Msg method is called.
+// until the Msg method is called. Errors registered with WithExpectedErrors are logged at their configured level.
 //
 // Example usage:
 //
@@ -207,7 +551,13 @@ func Warn(ctx context.Context) *zerolog.Event {
 //
 //	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
 func Err(ctx context.Context, err error) *zerolog.Event {
-	e := logger.Err(err).Ctx(ctx)
+	cfg := config()
+	level := zerolog.InfoLevel
+	if err != nil {
+		level = cfg.errorLevel(err)
+	}
+
+	e := errEvent(ctx, newEvent(ctx, level), err)
 
 	return event(ctx, e)
 }
@@ -228,7 +578,7 @@ func Err(ctx context.Context, err error) *zerolog.Event {
 //
 //	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
 func Error(ctx context.Context) *zerolog.Event {
-	e := logger.Error().Ctx(ctx)
+	e := newEvent(ctx, zerolog.ErrorLevel)
 
 	return event(ctx, e)
 }
@@ -249,7 +599,7 @@ func Error(ctx context.Context) *zerolog.Event {
 //
 //	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
 func Debug(ctx context.Context) *zerolog.Event {
-	e := logger.Debug().Ctx(ctx)
+	e := newEvent(ctx, zerolog.DebugLevel)
 
 	return event(ctx, e)
 }
@@ -257,7 +607,7 @@ func Debug(ctx context.Context) *zerolog.Event {
 // Fatal starts a new logging event at the "fatal" level.
 // This function uses a context.Context to extract necessary tracing information.
 // It returns a *zerolog.Event that is not sent until the Msg method is called.
-// The os.Exit(1) function is called by the Msg method, which terminates the program immediately.
+// The configured exit function (os.Exit by default) is called with code 1 by the Msg method, which terminates the program immediately.
 //
 // Example usage:
 //
@@ -271,12 +621,126 @@ func Debug(ctx context.Context) *zerolog.Event {
 //
 //	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
 func Fatal(ctx context.Context) *zerolog.Event {
-	e := logger.Fatal().Ctx(ctx)
+	return FatalCode(ctx, 1)
+}
+
+// FatalCode starts a new logging event at the "fatal" level which exits the program with the given code.
+// This function uses a context.Context to extract necessary tracing information.
+// It returns a *zerolog.Event that is not sent until the Msg method is called.
+// The code is written as the "exit_code" field, and the configured exit function (os.Exit by default)
+// is called with it by the Msg method after flushing the writer, which terminates the program immediately.
+// To make the last log event as informative as possible, the error stored in the context by WithError and the snapshot
+// stored by WithSnapshot are always attached, as the 'error' and 'snapshot' fields, alongside the context logger fields.
+//
+// Example usage:
+//
+//	logger.FatalCode(ctx, 3).Msg("unable to connect to the database")
+//
+// Params:
+//
+//	ctx (context.Context): The context from which to extract tracing information.
+//	code (int): The exit code of the program.
+//
+// Returns:
+//
+//	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
+func FatalCode(ctx context.Context, code int) *zerolog.Event {
+	cfg := config()
+	ctx = context.WithValue(ctx, exitCodeCtxKey{}, code)
+
+	l := FromContext(ctx)
+	e := l.WithLevel(zerolog.FatalLevel)
+	if e == nil {
+		// Mirrors zerolog, which exits even when the fatal level is disabled.
+		cfg.exitFunc(code)
+		return e
+	}
+
+	e = e.Int(exitCodeFieldName, code).Ctx(trackEvent(ctx, e))
+
+	// The fatal level is never changed, but the fields of the level resolvers are still written.
+	_, mods := resolveLevel(ctx, zerolog.FatalLevel)
+	for _, mod := range mods {
+		e = mod(e)
+	}
+
+	if err, ok := ctx.Value(boundErrCtxKey{}).(error); ok && err != nil {
+		// An error passed explicitly to the returned event takes precedence over the context error.
+		e = errOptions(ctx, e.AnErr(contextErrorFieldName, err), err)
+	}
+	if s, ok := ctx.Value(snapshotCtxKey{}).(*Snapshot); ok {
+		e = e.Object("snapshot", s)
+	}
 
 	return event(ctx, e)
 }
 
+// newEvent starts a new logging event from the context logger, at the level resolved by the configured level resolvers.
+func newEvent(ctx context.Context, level zerolog.Level) *zerolog.Event {
+	level, mods := resolveLevel(ctx, level)
+
+	l := FromContext(ctx)
+	e := l.WithLevel(level)
+	e = e.Ctx(trackEvent(ctx, e))
+
+	for _, mod := range mods {
+		e = mod(e)
+	}
+	return e
+}
+
+// resolveLevel applies the configured level resolvers to the level, returning the resolved level
+// and the modifiers they returned for the log event.
+func resolveLevel(ctx context.Context, level zerolog.Level) (zerolog.Level, []func(e *zerolog.Event) *zerolog.Event) {
+	cfg := config()
+	var mods []func(e *zerolog.Event) *zerolog.Event
+	for _, resolve := range cfg.levelResolvers {
+		var mod func(e *zerolog.Event) *zerolog.Event
+		if level, mod = resolve(ctx, level); mod != nil {
+			mods = append(mods, mod)
+		}
+	}
+	return level, mods
+}
+
+// errEvent attaches the error to the event, applying the configured error event modifiers when it is not nil.
+func errEvent(ctx context.Context, e *zerolog.Event, err error) *zerolog.Event {
+	if err == nil {
+		return e
+	}
+
+	return errOptions(ctx, e.Err(err), err)
+}
+
+// errOptions applies the error callbacks and the error fields of err to a log event.
+func errOptions(ctx context.Context, e *zerolog.Event, err error) *zerolog.Event {
+	cfg := config()
+	if len(cfg.errCallbacks) > 0 || cfg.contextErrors || cfg.spanErrors {
+		e = e.Ctx(context.WithValue(ctx, errCtxKey{}, err))
+	}
+
+	for _, opt := range cfg.errFields {
+		e = opt(ctx, e, err)
+	}
+
+	return e
+}
+
 func event(ctx context.Context, event *zerolog.Event) *zerolog.Event {
+	cfg := config()
+	if !event.Enabled() {
+		// Skips the event modifiers of events that will not be written, such as the ones below the configured level.
+		return event
+	}
+
+	if cfg.callers {
+		ctx = withCaller(ctx)
+	}
+
+	if cfg.optionTimeout > 0 && len(cfg.eventFields) > 0 {
+		return eventWithTimeout(ctx, event, cfg.eventFields, cfg.optionTimeout)
+	}
+
 	for _, opt := range cfg.eventFields {
 		event = opt(ctx, event)
 	}
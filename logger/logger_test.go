@@ -3,7 +3,9 @@ package logger
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/rs/zerolog"
@@ -18,7 +20,7 @@ var suts = map[string]struct {
 	"Info when Msg is invoked should write to buffer": {
 		arrange: func() *bytes.Buffer {
 			buff := &bytes.Buffer{}
-			logger = zerolog.New(buff)
+			useLogger(zerolog.New(buff))
 			return buff
 		},
 		act: func(ctx context.Context) {
@@ -33,7 +35,7 @@ var suts = map[string]struct {
 	"Warn when Msg is invoked should write to buffer": {
 		arrange: func() *bytes.Buffer {
 			buff := &bytes.Buffer{}
-			logger = zerolog.New(buff)
+			useLogger(zerolog.New(buff))
 			return buff
 		},
 		act: func(ctx context.Context) {
@@ -48,7 +50,7 @@ var suts = map[string]struct {
 	"Err when Msg is invoked should write to buffer with error field": {
 		arrange: func() *bytes.Buffer {
 			buff := &bytes.Buffer{}
-			logger = zerolog.New(buff)
+			useLogger(zerolog.New(buff))
 			return buff
 		},
 		act: func(ctx context.Context) {
@@ -64,7 +66,7 @@ var suts = map[string]struct {
 	"Error when Msg is invoked should write to buffer": {
 		arrange: func() *bytes.Buffer {
 			buff := &bytes.Buffer{}
-			logger = zerolog.New(buff)
+			useLogger(zerolog.New(buff))
 			return buff
 		},
 		act: func(ctx context.Context) {
@@ -79,7 +81,7 @@ var suts = map[string]struct {
 	"Debug when Msg is invoked should write to buffer": {
 		arrange: func() *bytes.Buffer {
 			buff := &bytes.Buffer{}
-			logger = zerolog.New(buff)
+			useLogger(zerolog.New(buff))
 			return buff
 		},
 		act: func(ctx context.Context) {
@@ -94,7 +96,7 @@ var suts = map[string]struct {
 	"Configure when adding contextual fields should have fields into log message": {
 		arrange: func() *bytes.Buffer {
 			buff := &bytes.Buffer{}
-			logger = Configure(func(cfg *LoggerConfig) {
+			Configure(func(cfg *LoggerConfig) {
 				cfg.WithWriter(buff)
 				cfg.WithContextFields(func(c zerolog.Context) zerolog.Context {
 					return c.Str("context", "value")
@@ -112,7 +114,7 @@ var suts = map[string]struct {
 	"Configure when adding event fields should have fields into log message": {
 		arrange: func() *bytes.Buffer {
 			buff := &bytes.Buffer{}
-			logger = Configure(func(cfg *LoggerConfig) {
+			Configure(func(cfg *LoggerConfig) {
 				cfg.WithWriter(buff)
 				cfg.WithEventFields(func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
 					return e.Str("trace_id", "123456")
@@ -127,6 +129,60 @@ var suts = map[string]struct {
 			assert.Contains(t, b.String(), "\"trace_id\":\"123456\"")
 		},
 	},
+	"Configure when WithUTC is used should write timestamps in UTC": {
+		arrange: func() *bytes.Buffer {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithUTC()
+			})
+			return buff
+		},
+		act: func(ctx context.Context) {
+			Info(ctx).Msg("utc log")
+		},
+		assert: func(t *testing.T, b *bytes.Buffer) {
+			var entry struct {
+				Time string `json:"time"`
+			}
+			assert.NoError(t, json.Unmarshal(b.Bytes(), &entry))
+			assert.True(t, strings.HasSuffix(entry.Time, "Z"))
+		},
+	},
+	"Configure when using production defaults should discard debug events": {
+		arrange: func() *bytes.Buffer {
+			buff := &bytes.Buffer{}
+			Configure(RecommendProductionDefaults(), func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+			})
+			return buff
+		},
+		act: func(ctx context.Context) {
+			Debug(ctx).Msg("debug message")
+			Info(ctx).Msg("info message")
+		},
+		assert: func(t *testing.T, b *bytes.Buffer) {
+			msg := b.String()
+			assert.NotContains(t, msg, "\"message\":\"debug message\"")
+			assert.Contains(t, msg, "\"message\":\"info message\"")
+		},
+	},
+	"Configure when overriding production defaults should apply later options": {
+		arrange: func() *bytes.Buffer {
+			buff := &bytes.Buffer{}
+			Configure(RecommendProductionDefaults(), func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithLevel(zerolog.DebugLevel)
+			})
+			return buff
+		},
+		act: func(ctx context.Context) {
+			Debug(ctx).Msg("debug message")
+		},
+		assert: func(t *testing.T, b *bytes.Buffer) {
+			assert.Contains(t, b.String(), "\"message\":\"debug message\"")
+		},
+	},
 }
 
 func TestLogLevelFuncs(t *testing.T) {
@@ -140,3 +196,10 @@ func TestLogLevelFuncs(t *testing.T) {
 		})
 	}
 }
+
+// useLogger replaces the global logger with l, along with a default configuration.
+func useLogger(l zerolog.Logger) {
+	cfg := newLoggerConfig()
+	cfg.root = l
+	current.Store(cfg)
+}
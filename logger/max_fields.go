@@ -0,0 +1,71 @@
+package logger
+
+import "github.com/rs/zerolog"
+
+// WithMaxFields caps the number of fields of every log event at write time, guarding against events growing
+// unbounded, such as the ones enriched in a loop. The 'level', 'message', 'time' and 'error' fields are always kept,
+// and the other fields are kept in order until the cap is reached, dropping the overflow from the tail.
+// The 'exit_code' field of fatal events and the internal fields of the logger are always kept without being counted.
+// Truncated log events get the 'fields_truncated' field, which is not counted by the cap, with the number of dropped fields.
+//
+// Example usage:
+//
+//	cfg.WithMaxFields(64)
+//
+// Params:
+//
+//	n (int): The maximum number of fields of a log event, or zero or less to not cap them.
+func (cfg *LoggerConfig) WithMaxFields(n int) {
+	if n <= 0 {
+		return
+	}
+
+	protected := map[string]bool{
+		zerolog.LevelFieldName:     true,
+		zerolog.MessageFieldName:   true,
+		zerolog.TimestampFieldName: true,
+		zerolog.ErrorFieldName:     true,
+	}
+	exempt := map[string]bool{
+		exitCodeFieldName:     true,
+		traceSampledFieldName: true,
+	}
+
+	cfg.recordOptions = append(cfg.recordOptions, func(r *record) {
+		counted := 0
+		for _, f := range r.fields {
+			if !exempt[f.key] {
+				counted++
+			}
+		}
+		if counted <= n {
+			return
+		}
+
+		// The protected fields take their slots first, so the remaining slots go to the leading fields.
+		slots := n
+		for _, f := range r.fields {
+			if protected[f.key] {
+				slots--
+			}
+		}
+
+		fields := make([]field, 0, n)
+		truncated := 0
+
+		for _, f := range r.fields {
+			switch {
+			case protected[f.key], exempt[f.key]:
+				fields = append(fields, f)
+			case slots > 0:
+				fields = append(fields, f)
+				slots--
+			default:
+				truncated++
+			}
+		}
+
+		r.fields = fields
+		r.set("fields_truncated", truncated)
+	})
+}
@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithMaxFields(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithMaxFields(6)
+	})
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	t.Run("WithMaxFields when event has too many fields should keep the leading ones and mark the truncation", func(t *testing.T) {
+		e := Err(context.TODO(), errors.New("boom"))
+		for i := range 100 {
+			e = e.Int("field_"+strconv.Itoa(i), i)
+		}
+		e.Msg("enriched in a loop")
+
+		f := fields()
+		assert.Len(t, f, 7)
+		assert.Equal(t, "error", f["level"])
+		assert.Equal(t, "boom", f["error"])
+		assert.Equal(t, "enriched in a loop", f["message"])
+		assert.Contains(t, f, "time")
+		assert.Contains(t, f, "field_0")
+		assert.Contains(t, f, "field_1")
+		assert.NotContains(t, f, "field_2")
+		assert.Equal(t, float64(98), f["fields_truncated"])
+	})
+
+	t.Run("WithMaxFields when event is within the cap should not change it", func(t *testing.T) {
+		Info(context.TODO()).Int("field_0", 0).Msg("small")
+
+		f := fields()
+		assert.Contains(t, f, "field_0")
+		assert.NotContains(t, f, "fields_truncated")
+	})
+
+	t.Run("WithMaxFields when fatal event is truncated should keep the exit code uncounted", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithMaxFields(4)
+			cfg.WithExitFunc(func(code int) {})
+		})
+
+		e := Fatal(context.TODO())
+		for i := range 3 {
+			e = e.Int("field_"+strconv.Itoa(i), i)
+		}
+		e.Msg("fatal")
+
+		var f map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &f))
+		assert.Equal(t, float64(1), f["exit_code"])
+		assert.Contains(t, f, "field_0")
+		assert.NotContains(t, f, "field_1")
+		assert.Equal(t, float64(2), f["fields_truncated"])
+	})
+
+	for _, n := range []int{0, -1} {
+		t.Run("WithMaxFields when cap is "+strconv.Itoa(n)+" should not cap the fields", func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithMaxFields(n)
+			})
+
+			e := Info(context.TODO())
+			for i := range 10 {
+				e = e.Int("field_"+strconv.Itoa(i), i)
+			}
+			e.Msg("uncapped")
+
+			var f map[string]any
+			assert.NoError(t, json.Unmarshal(buff.Bytes(), &f))
+			assert.Contains(t, f, "field_9")
+			assert.NotContains(t, f, "fields_truncated")
+		})
+	}
+}
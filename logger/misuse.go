@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+type eventGoroutineCtxKey struct{}
+
+// WithEventMisuseDetection makes the events created by the logging functions, such as Info and Err, remember the goroutine
+// that created them, and panic when Msg is called from a different goroutine, catching events shared across goroutines,
+// which corrupts the output. It is meant for tests and debugging, since identifying goroutines is costly, and is disabled by default.
+// Events created from a logger returned by FromContext are not checked.
+//
+// Example usage:
+//
+//	logger.Configure(func(cfg *logger.LoggerConfig) {
+//	    cfg.WithEventMisuseDetection()
+//	})
+//	e := logger.Info(ctx)
+//	go e.Msg("shared") // Panics.
+func (cfg *LoggerConfig) WithEventMisuseDetection() {
+	cfg.eventMisuse = true
+
+	cfg.hooks = append(cfg.hooks, zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+		// The goroutine is carried by the event context, which is reset for every event, so pooled events never see a stale one.
+		created, ok := e.GetCtx().Value(eventGoroutineCtxKey{}).(uint64)
+		if !ok {
+			return
+		}
+		if sent := goroutineID(); created != sent {
+			panic(fmt.Sprintf("logger: event created on goroutine %d was sent from goroutine %d", created, sent))
+		}
+	}))
+}
+
+// trackEvent returns a copy of ctx carrying the goroutine creating the event, when WithEventMisuseDetection is enabled.
+func trackEvent(ctx context.Context, e *zerolog.Event) context.Context {
+	if !config().eventMisuse || e == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, eventGoroutineCtxKey{}, goroutineID())
+}
+
+// goroutineID returns the identifier of the current goroutine, parsed from the "goroutine N [running]:" stack header.
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+
+	id, _ := strconv.ParseUint(string(buf[:bytes.IndexByte(buf, ' ')]), 10, 64)
+	return id
+}
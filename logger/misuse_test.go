@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithEventMisuseDetection(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventMisuseDetection()
+	})
+
+	t.Run("WithEventMisuseDetection when event is sent from another goroutine should panic", func(t *testing.T) {
+		var creator uint64
+		created := make(chan *zerolog.Event)
+		go func() {
+			creator = goroutineID()
+			created <- Info(context.TODO()).Str("order_id", "42")
+		}()
+		e := <-created
+
+		msg := fmt.Sprintf("logger: event created on goroutine %d was sent from goroutine %d", creator, goroutineID())
+		assert.PanicsWithValue(t, msg, func() { e.Msg("shared") })
+	})
+
+	t.Run("WithEventMisuseDetection when event is sent from its goroutine should write it", func(t *testing.T) {
+		buff.Reset()
+
+		assert.NotPanics(t, func() { Info(context.TODO()).Msg("owned") })
+		assert.Contains(t, buff.String(), "owned")
+	})
+
+	t.Run("WithEventMisuseDetection when tracked events were abandoned should not panic for untracked events", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 100; i++ {
+				Info(context.TODO()).Msg("owned")
+				_ = Info(context.TODO()).Discard()
+			}
+		}()
+		<-done
+
+		assert.NotPanics(t, func() {
+			l := FromContext(context.TODO())
+			for i := 0; i < 100; i++ {
+				l.Info().Msg("untracked")
+			}
+		})
+	})
+}
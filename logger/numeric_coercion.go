@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// WithNumericCoercion converts, at write time, the string values of the given top-level keys into JSON numbers
+// when they are valid JSON numbers, such as "42" or "-1.5e3", so log stores index them as numbers regardless of how
+// the call sites or event options wrote them. Values that are not numbers, such as "42a" or "0x2A", are left as strings.
+//
+// Example usage:
+//
+//	cfg.WithNumericCoercion("user_id", "amount")
+//	logger.Info(ctx).Str("user_id", "42").Msg("charged") // "user_id":42
+//
+// Params:
+//
+//	keys (...string): The keys whose string values are converted.
+func (cfg *LoggerConfig) WithNumericCoercion(keys ...string) {
+	cfg.recordOptions = append(cfg.recordOptions, func(r *record) {
+		for i, f := range r.fields {
+			if !slices.Contains(keys, f.key) {
+				continue
+			}
+
+			var s string
+			if err := json.Unmarshal(f.value, &s); err != nil || !numeric(s) {
+				continue
+			}
+			r.fields[i].value = json.RawMessage(s)
+		}
+	})
+}
+
+// numeric reports whether s is a valid JSON number.
+func numeric(s string) bool {
+	if s == "" || (s[0] != '-' && (s[0] < '0' || s[0] > '9')) {
+		return false
+	}
+
+	var n json.Number
+	return json.Unmarshal([]byte(s), &n) == nil
+}
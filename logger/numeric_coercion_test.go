@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithNumericCoercion(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithNumericCoercion("user_id", "amount")
+	})
+
+	suts := map[string]struct {
+		value    string
+		expected string
+	}{
+		"WithNumericCoercion when value is an integer should write a number":          {value: "42", expected: `"user_id":42`},
+		"WithNumericCoercion when value is a float should write a number":             {value: "-1.5e3", expected: `"user_id":-1.5e3`},
+		"WithNumericCoercion when value is not numeric should keep the string":        {value: "42a", expected: `"user_id":"42a"`},
+		"WithNumericCoercion when value is a hexadecimal should keep the string":      {value: "0x2A", expected: `"user_id":"0x2A"`},
+		"WithNumericCoercion when value has a leading zero should keep the string":    {value: "007", expected: `"user_id":"007"`},
+		"WithNumericCoercion when value is padded with spaces should keep the string": {value: " 42", expected: `"user_id":" 42"`},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff.Reset()
+
+			Info(context.TODO()).Str("user_id", sut.value).Str("order_id", "42").Msg("charged")
+
+			assert.Contains(t, buff.String(), sut.expected)
+			assert.Contains(t, buff.String(), `"order_id":"42"`)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package logger
+
+import "github.com/rs/zerolog"
+
+// WithNumericLevel writes the level as an integer under the given field, alongside the text 'level' field,
+// following the zerolog level ordering (trace=-1, debug=0, info=1, warn=2, error=3, fatal=4, panic=5),
+// so dashboards such as Grafana can color and compare log events without parsing the level.
+// Log events without level are not changed.
+//
+// Example usage:
+//
+//	cfg.WithNumericLevel("level_num")
+//	logger.Error(ctx).Msg("payment failed") // "level":"error","level_num":3
+//
+// Params:
+//
+//	fieldName (string): The name of the field holding the numeric level.
+func (cfg *LoggerConfig) WithNumericLevel(fieldName string) {
+	cfg.hooks = append(cfg.hooks, zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+		if level >= zerolog.TraceLevel && level <= zerolog.PanicLevel {
+			e.Int(fieldName, int(level))
+		}
+	}))
+}
@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithNumericLevel(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithNumericLevel("level_num")
+	})
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	t.Run("Info when numeric level is enabled should write the info level number", func(t *testing.T) {
+		Info(context.TODO()).Msg("test")
+
+		f := fields()
+		assert.Equal(t, "info", f["level"])
+		assert.Equal(t, float64(1), f["level_num"])
+	})
+
+	t.Run("Error when numeric level is enabled should write the error level number", func(t *testing.T) {
+		Error(context.TODO()).Msg("test")
+
+		f := fields()
+		assert.Equal(t, "error", f["level"])
+		assert.Equal(t, float64(3), f["level_num"])
+	})
+
+	t.Run("Log when event has no level should not write the level number", func(t *testing.T) {
+		l := FromContext(context.TODO())
+		l.Log().Msg("test")
+
+		assert.NotContains(t, fields(), "level_num")
+	})
+}
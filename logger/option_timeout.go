@@ -0,0 +1,112 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"runtime"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// WithOptionTimeout runs the event options, such as the ones added by WithEventFields, under a watchdog,
+// so an option blocking the caller, such as a misbehaving context extractor, does not stall it.
+// When an option runs for longer than d, the remaining options are skipped and the 'log_option_timeout' field
+// is written with the name of the function of the slow option. The partially built log event is still written.
+//
+// Since options run on another goroutine, they write their fields to a scratch event, later copied into the log event,
+// which adds a goroutine and a decoding of the fields per event. Options must only add fields, as the other changes
+// to the scratch event are lost. They should also be free of side effects, such as the counter of WithSequence,
+// as the slow option keeps running after the timeout. The options after it are not run, and the context given
+// to the options is cancelled once the timeout is exceeded.
+//
+// Example usage:
+//
+//	cfg.WithOptionTimeout(50 * time.Millisecond)
+//
+// Params:
+//
+//	d (time.Duration): The maximum duration of each event option.
+func (cfg *LoggerConfig) WithOptionTimeout(d time.Duration) {
+	cfg.optionTimeout = d
+}
+
+// eventWithTimeout applies the event options to the event, skipping the remaining ones once an option exceeds the timeout.
+// The options run one after the other on a single goroutine, stopped between options once the timeout is exceeded.
+func eventWithTimeout(ctx context.Context, e *zerolog.Event, opts []LogEventOption, timeout time.Duration) *zerolog.Event {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan []byte, len(opts))
+	go func() {
+		buf := acquireBuffer()
+		defer releaseBuffer(buf)
+
+		for _, opt := range opts {
+			if ctx.Err() != nil {
+				return
+			}
+			results <- scratchFields(ctx, buf, opt)
+		}
+	}()
+
+	watchdog := time.NewTimer(timeout)
+	defer watchdog.Stop()
+
+	fields := []byte{'{'}
+	for _, opt := range opts {
+		select {
+		case p := <-results:
+			if len(p) > 0 && len(fields) > 1 {
+				fields = append(fields, ',')
+			}
+			fields = append(fields, p...)
+
+			if !watchdog.Stop() {
+				select {
+				case <-watchdog.C:
+				default:
+				}
+			}
+			watchdog.Reset(timeout)
+		case <-watchdog.C:
+			return copyFields(e, append(fields, '}')).Str("log_option_timeout", runtime.FuncForPC(reflect.ValueOf(opt).Pointer()).Name())
+		}
+	}
+
+	return copyFields(e, append(fields, '}'))
+}
+
+// scratchFields returns the fields written by the option to a scratch event, as the members of a JSON object
+// without the surrounding braces. The buffer is reused across calls.
+func scratchFields(ctx context.Context, buf *bytes.Buffer, opt LogEventOption) []byte {
+	buf.Reset()
+	l := zerolog.New(buf)
+
+	if e := opt(ctx, l.Log().Ctx(ctx)); e != nil {
+		e.Send()
+	}
+
+	p := bytes.TrimSpace(buf.Bytes())
+	p = bytes.TrimSuffix(bytes.TrimPrefix(p, []byte{'{'}), []byte{'}'})
+	return bytes.Clone(p)
+}
+
+// copyFields writes the fields of the JSON object to the event.
+func copyFields(e *zerolog.Event, p []byte) *zerolog.Event {
+	if len(p) <= 2 {
+		return e
+	}
+
+	r, err := decodeRecord(zerolog.NoLevel, p)
+	if err != nil {
+		return e
+	}
+	defer releaseRecord(r)
+
+	for _, f := range r.fields {
+		e = e.RawJSON(f.key, f.value)
+	}
+	return e
+}
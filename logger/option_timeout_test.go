@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithOptionTimeout(t *testing.T) {
+	buff := &bytes.Buffer{}
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithOptionTimeout(20 * time.Millisecond)
+		cfg.WithEventFields(func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+			return e.Str("fast", "yes")
+		})
+		cfg.WithEventFields(slowOption(release))
+		cfg.WithEventFields(func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+			return e.Str("skipped", "no")
+		})
+	})
+
+	t.Run("WithOptionTimeout when an option is slow should write the event with the timeout field", func(t *testing.T) {
+		start := time.Now()
+		Info(context.TODO()).Int("order_id", 42).Msg("test")
+		elapsed := time.Since(start)
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		assert.Less(t, elapsed, time.Second)
+		assert.Equal(t, "test", fields["message"])
+		assert.Equal(t, float64(42), fields["order_id"])
+		assert.Equal(t, "yes", fields["fast"])
+		assert.Contains(t, fields["log_option_timeout"], "slowOption")
+		assert.NotContains(t, fields, "skipped")
+	})
+}
+
+func TestWithOptionTimeoutSkippedOptions(t *testing.T) {
+	buff := &bytes.Buffer{}
+	release := make(chan struct{})
+	var calls atomic.Int32
+
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithOptionTimeout(20 * time.Millisecond)
+		cfg.WithEventFields(slowOption(release))
+		cfg.WithEventFields(func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+			calls.Add(1)
+			return e
+		})
+	})
+
+	t.Run("WithOptionTimeout when an option times out should not run the options after it", func(t *testing.T) {
+		Info(context.TODO()).Msg("test")
+		close(release)
+		time.Sleep(20 * time.Millisecond)
+
+		assert.Contains(t, buff.String(), "log_option_timeout")
+		assert.Zero(t, calls.Load())
+	})
+}
+
+func slowOption(release chan struct{}) LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		<-release
+		return e.Str("slow", "yes")
+	}
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const instrumentationName = "github.com/mitz-it/go-toolkit/logger"
+
+// WithLevelCounter counts the log events sent at each level with the 'log.events' counter of the meter provider,
+// carrying the 'level' attribute. Events are counted when sent, before being written: the ones discarded by the options
+// configured before it are not counted, while the ones dropped afterwards, such as by a later WithThrottle,
+// a full buffer of WithBufferedWriter or an open breaker of WithWriterCircuitBreaker, are.
+//
+// Example usage:
+//
+//	cfg.WithLevelCounter(otel.GetMeterProvider())
+//
+// Params:
+//
+//	mp (metric.MeterProvider): The meter provider creating the counter.
+func (cfg *LoggerConfig) WithLevelCounter(mp metric.MeterProvider) {
+	counter, err := mp.Meter(instrumentationName).Int64Counter("log.events",
+		metric.WithDescription("The number of sent log events, by level."))
+	if err != nil {
+		writeError(err)
+		return
+	}
+
+	cfg.hooks = append(cfg.hooks, zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+		if !e.Enabled() {
+			return
+		}
+		counter.Add(context.Background(), 1, metric.WithAttributes(attribute.String("level", level.String())))
+	}))
+}
+
+// WithOTelObservability returns an option bundle enabling the OpenTelemetry integrations together:
+// the 'trace_id' and 'span_id' fields of TraceFields, the span status and error events of WithSpanStatusOnError,
+// and the per-level counters of WithLevelCounter. Spans are taken from the context of each log event,
+// so log events created with a context without a span are not changed. Passing a nil meter provider disables the counters,
+// and each integration can also be enabled on its own.
+//
+// Example usage:
+//
+//	logger.Configure(
+//		logger.WithOTelObservability(otel.GetMeterProvider()),
+//	)
+//
+// Params:
+//
+//	mp (metric.MeterProvider): The meter provider creating the per-level counters, or nil.
+//
+// Returns:
+//
+//	LoggerOption: The option bundle to be passed to Configure.
+func WithOTelObservability(mp metric.MeterProvider) LoggerOption {
+	return func(cfg *LoggerConfig) {
+		cfg.WithEventFields(TraceFields())
+		cfg.WithSpanStatusOnError()
+		if mp != nil {
+			cfg.WithLevelCounter(mp)
+		}
+	}
+}
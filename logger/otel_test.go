@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithOTelObservability(t *testing.T) {
+	buff := &bytes.Buffer{}
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	Configure(
+		func(cfg *LoggerConfig) { cfg.WithWriter(buff) },
+		WithOTelObservability(mp),
+	)
+
+	ctx, span := tp.Tracer("test").Start(context.TODO(), "operation")
+	Info(ctx).Msg("started")
+	Err(ctx, errors.New("declined")).Msg("failed")
+	span.End()
+
+	t.Run("WithOTelObservability should write the trace fields", func(t *testing.T) {
+		assert.Contains(t, buff.String(), "\"trace_id\":\""+span.SpanContext().TraceID().String()+"\"")
+		assert.Contains(t, buff.String(), "\"span_id\":\""+span.SpanContext().SpanID().String()+"\"")
+	})
+
+	t.Run("WithOTelObservability should record the errors on the span", func(t *testing.T) {
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Len(t, spans[0].Events, 1)
+		assert.Equal(t, "exception", spans[0].Events[0].Name)
+	})
+
+	t.Run("WithOTelObservability should count the log events by level", func(t *testing.T) {
+		rm := metricdata.ResourceMetrics{}
+		assert.NoError(t, reader.Collect(context.Background(), &rm))
+		assert.Len(t, rm.ScopeMetrics, 1)
+		assert.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+		sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		counts := map[string]int64{}
+		for _, dp := range sum.DataPoints {
+			level, _ := dp.Attributes.Value(attribute.Key("level"))
+			counts[level.AsString()] = dp.Value
+		}
+		assert.Equal(t, map[string]int64{"info": 1, "error": 1}, counts)
+	})
+
+	t.Run("WithOTelObservability when meter provider is nil should still write the trace fields", func(t *testing.T) {
+		buff.Reset()
+		Configure(
+			func(cfg *LoggerConfig) { cfg.WithWriter(buff) },
+			WithOTelObservability(nil),
+		)
+
+		Info(ctx).Msg("started")
+
+		assert.Contains(t, buff.String(), "trace_id")
+	})
+
+	t.Run("WithLevelCounter when the event is discarded should not count it", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		Configure(
+			func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.hooks = append(cfg.hooks, zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+					if msg == "discarded" {
+						e.Discard()
+					}
+				}))
+				cfg.WithLevelCounter(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+			},
+		)
+
+		Info(ctx).Msg("discarded")
+		Info(ctx).Msg("counted")
+
+		rm := metricdata.ResourceMetrics{}
+		assert.NoError(t, reader.Collect(context.Background(), &rm))
+		sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		assert.Equal(t, int64(1), sum.DataPoints[0].Value)
+	})
+}
@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/rs/zerolog"
+)
+
+// maxPanicStackDepth bounds the number of frames written in the stack of a recovered panic.
+const maxPanicStackDepth = 32
+
+// panicDict returns the 'panic' object of a recovered panic, with its 'value', its Go 'type'
+// and the 'stack' of frames leading to it. Errors are written as their message, other values as JSON.
+// It must be called by the deferred function recovering the panic, so the stack is still the panicking one.
+func panicDict(r any) *zerolog.Event {
+	d := zerolog.Dict().Str("type", fmt.Sprintf("%T", r))
+
+	if err, ok := r.(error); ok {
+		d = d.Str("value", err.Error())
+	} else {
+		d = d.Interface("value", r)
+	}
+
+	return d.Array("stack", panicStack())
+}
+
+// panicStack returns the frames of the panicking goroutine, starting at the frame that panicked.
+func panicStack() *zerolog.Array {
+	pc := make([]uintptr, maxPanicStackDepth+8)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+
+	// The frames of the deferred function recovering the panic are skipped up to runtime.gopanic.
+	stack := []runtime.Frame{}
+	for {
+		frame, more := frames.Next()
+		if frame.Function == "runtime.gopanic" {
+			stack = stack[:0]
+		} else {
+			stack = append(stack, frame)
+		}
+		if !more {
+			break
+		}
+	}
+
+	arr := zerolog.Arr()
+	for i, frame := range stack {
+		if i == maxPanicStackDepth {
+			break
+		}
+		arr = arr.Dict(zerolog.Dict().Str("func", frame.Function).Str("file", frame.File).Int("line", frame.Line))
+	}
+	return arr
+}
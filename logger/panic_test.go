@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPanicDict(t *testing.T) {
+	recovered := func(fn func()) map[string]any {
+		buff := &bytes.Buffer{}
+		l := zerolog.New(buff)
+
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					l.Error().Dict("panic", panicDict(r)).Msg("panicked")
+				}
+			}()
+			fn()
+		}()
+
+		var fields struct {
+			Panic map[string]any `json:"panic"`
+		}
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		return fields.Panic
+	}
+
+	t.Run("panicDict when panic value is an error should write the error message and type", func(t *testing.T) {
+		p := recovered(func() { panic(errors.New("boom")) })
+
+		assert.Equal(t, "boom", p["value"])
+		assert.Equal(t, "*errors.errorString", p["type"])
+	})
+
+	t.Run("panicDict when panic value is a string should write the string and type", func(t *testing.T) {
+		p := recovered(func() { panic("boom") })
+
+		assert.Equal(t, "boom", p["value"])
+		assert.Equal(t, "string", p["type"])
+	})
+
+	t.Run("panicDict when panic value is an int should write the number", func(t *testing.T) {
+		p := recovered(func() { panic(42) })
+
+		assert.Equal(t, float64(42), p["value"])
+		assert.Equal(t, "int", p["type"])
+	})
+
+	t.Run("panicDict when panic is recovered should write the stack starting at the panicking frame", func(t *testing.T) {
+		p := recovered(func() { panic("boom") })
+
+		stack := p["stack"].([]any)
+		assert.NotEmpty(t, stack)
+
+		top := stack[0].(map[string]any)
+		assert.Contains(t, top["func"], "TestPanicDict.func")
+		assert.Contains(t, top["file"], "panic_test.go")
+		assert.Greater(t, top["line"], float64(0))
+	})
+}
@@ -0,0 +1,31 @@
+package logger
+
+import "slices"
+
+// WithPIIFields tags every log event, at write time, with the '_pii_fields' array listing which of the given keys are present
+// among its top-level fields, in order of appearance, so data governance tooling can apply retention policies downstream.
+// Values are left intact, since redaction is a separate concern handled by options such as WithRedactionPolicy.
+// Log events without PII fields are not changed.
+//
+// Example usage:
+//
+//	cfg.WithPIIFields("email", "phone", "document")
+//	logger.Info(ctx).Str("email", "jane@example.com").Str("plan", "pro").Msg("signed up") // "_pii_fields":["email"]
+//
+// Params:
+//
+//	keys (...string): The keys of the fields containing PII.
+func (cfg *LoggerConfig) WithPIIFields(keys ...string) {
+	cfg.recordOptions = append(cfg.recordOptions, func(r *record) {
+		present := []string{}
+		for _, f := range r.fields {
+			if slices.Contains(keys, f.key) && !slices.Contains(present, f.key) {
+				present = append(present, f.key)
+			}
+		}
+
+		if len(present) > 0 {
+			r.set("_pii_fields", present)
+		}
+	})
+}
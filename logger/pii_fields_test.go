@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithPIIFields(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithPIIFields("email", "phone", "document")
+	})
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	t.Run("WithPIIFields when event has PII fields should list only the present ones", func(t *testing.T) {
+		Info(context.TODO()).Str("phone", "+55 11 99999-0000").Str("plan", "pro").Str("email", "jane@example.com").Msg("signed up")
+
+		f := fields()
+		assert.Equal(t, []any{"phone", "email"}, f["_pii_fields"])
+		assert.Equal(t, "jane@example.com", f["email"])
+		assert.Equal(t, "+55 11 99999-0000", f["phone"])
+	})
+
+	t.Run("WithPIIFields when event has no PII fields should not tag it", func(t *testing.T) {
+		Info(context.TODO()).Str("plan", "pro").Msg("upgraded")
+
+		assert.NotContains(t, fields(), "_pii_fields")
+	})
+}
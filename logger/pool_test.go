@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithPooling(t *testing.T) {
+	buff := &lockedBuffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithPooling()
+		cfg.WithMaxFields(10)
+	})
+	t.Cleanup(func() {
+		Configure()
+	})
+
+	t.Run("Info when pooled records are reused concurrently should not leak fields across events", func(t *testing.T) {
+		ctx := WithRedactionPolicy(context.TODO(), []string{"password"})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				Info(ctx).Bool(fmt.Sprintf("field_%d", i), true).Str("password", "hunter2").Msg("pooled")
+			}(i)
+		}
+		wg.Wait()
+
+		lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+		assert.Len(t, lines, 100)
+		for _, line := range lines {
+			var fields map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(line), &fields))
+			assert.Len(t, fields, 5, line)
+			assert.Equal(t, secretMask, fields["password"])
+		}
+	})
+
+	t.Run("message when pooling is enabled should decode the message", func(t *testing.T) {
+		assert.Equal(t, "pooled", message([]byte(`{"message":"pooled"}`)))
+	})
+}
+
+func BenchmarkDecodeFields(b *testing.B) {
+	p := []byte(`{"level":"info","time":"2024-05-01T12:00:00Z","request_id":"123","user_id":"42","message":"pooled"}`)
+
+	for _, enabled := range []bool{false, true} {
+		b.Run(fmt.Sprintf("pooling=%t", enabled), func(b *testing.B) {
+			pooling.Store(enabled)
+			defer pooling.Store(false)
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				fields, _ := decodeFields(p)
+				var msg string
+				_ = json.Unmarshal(fields["message"], &msg)
+				releaseFields(fields)
+			}
+		})
+	}
+}
+
+func BenchmarkRecordWriter(b *testing.B) {
+	p := []byte(`{"level":"info","time":"2024-05-01T12:00:00Z","request_id":"123","user_id":"42","message":"pooled"}`)
+	w := &recordWriter{w: zerolog.MultiLevelWriter(io.Discard), opts: []recordOption{func(r *record) {}}}
+
+	for _, enabled := range []bool{false, true} {
+		b.Run(fmt.Sprintf("pooling=%t", enabled), func(b *testing.B) {
+			pooling.Store(enabled)
+			defer pooling.Store(false)
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				_, _ = w.WriteLevel(zerolog.InfoLevel, p)
+			}
+		})
+	}
+}
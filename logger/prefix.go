@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// WithMessagePrefix prepends the prefix to the message of every log event at write time,
+// such as "[staging] payment failed", for quick visual filtering of shared log pipelines.
+// Messages that already start with the prefix are not prefixed again.
+// Use WithPrefixAsEnvField to write the prefix as a structured field in FormatJSON instead.
+//
+// Example usage:
+//
+//	cfg.WithMessagePrefix("[staging]")
+//
+// Params:
+//
+//	prefix (string): The prefix of the messages.
+func (cfg *LoggerConfig) WithMessagePrefix(prefix string) {
+	cfg.recordOptions = append(cfg.recordOptions, func(r *record) {
+		if cfg.prefixAsEnv && cfg.format == FormatJSON {
+			r.set("env", strings.Trim(prefix, "[] "))
+			return
+		}
+
+		msg, ok := r.str(zerolog.MessageFieldName)
+		if !ok || strings.HasPrefix(msg, prefix+" ") {
+			return
+		}
+		r.set(zerolog.MessageFieldName, prefix+" "+msg)
+	})
+}
+
+// WithPrefixAsEnvField makes WithMessagePrefix write the 'env' field in FormatJSON, such as "env":"staging",
+// with the prefix stripped of brackets, instead of changing the message. FormatConsole messages are still prefixed.
+//
+// Example usage:
+//
+//	cfg.WithMessagePrefix("[staging]")
+//	cfg.WithPrefixAsEnvField()
+func (cfg *LoggerConfig) WithPrefixAsEnvField() {
+	cfg.prefixAsEnv = true
+}
@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithMessagePrefix(t *testing.T) {
+	suts := map[string]struct {
+		format Format
+		env    bool
+		msg    string
+		assert func(t *testing.T, out string)
+	}{
+		"WithMessagePrefix when format is JSON should prefix the message": {
+			format: FormatJSON,
+			msg:    "started",
+			assert: func(t *testing.T, out string) {
+				assert.Contains(t, out, "\"message\":\"[staging] started\"")
+			},
+		},
+		"WithMessagePrefix when message is already prefixed should not prefix it again": {
+			format: FormatJSON,
+			msg:    "[staging] started",
+			assert: func(t *testing.T, out string) {
+				assert.Equal(t, 1, strings.Count(out, "[staging]"))
+			},
+		},
+		"WithMessagePrefix when format is console should prefix the rendered line": {
+			format: FormatConsole,
+			msg:    "started",
+			assert: func(t *testing.T, out string) {
+				assert.Contains(t, out, "[staging] started")
+			},
+		},
+		"WithPrefixAsEnvField when format is JSON should write the env field": {
+			format: FormatJSON,
+			env:    true,
+			msg:    "started",
+			assert: func(t *testing.T, out string) {
+				assert.Contains(t, out, "\"env\":\"staging\"")
+				assert.Contains(t, out, "\"message\":\"started\"")
+			},
+		},
+		"WithPrefixAsEnvField when format is console should prefix the rendered line": {
+			format: FormatConsole,
+			env:    true,
+			msg:    "started",
+			assert: func(t *testing.T, out string) {
+				assert.Contains(t, out, "[staging] started")
+				assert.NotContains(t, out, "env")
+			},
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithFormat(sut.format)
+				cfg.WithMessagePrefix("[staging]")
+				if sut.env {
+					cfg.WithPrefixAsEnvField()
+				}
+			})
+
+			Info(context.TODO()).Msg(sut.msg)
+
+			sut.assert(t, buff.String())
+		})
+	}
+}
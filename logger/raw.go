@@ -0,0 +1,26 @@
+package logger
+
+import "bytes"
+
+// Raw writes a pre-rendered log line verbatim to the output destination, appending a newline if absent.
+// The line bypasses the event pipeline, so it is neither encoded nor filtered by level, samplers or hooks,
+// but it is written through the compressed file or batching writer when configured. The line is written
+// with a single call, so it does not interleave with structured log events. Lines written by Raw are not
+// held by WithBufferedWriter and may reach the output destination ahead of the buffered log events.
+//
+// Example usage:
+//
+//	logger.Raw([]byte(`{"level":"info","message":"rendered by the subsystem"}`))
+//
+// Params:
+//
+//	p ([]byte): The log line to write.
+func Raw(p []byte) {
+	if !bytes.HasSuffix(p, []byte("\n")) {
+		p = append(p[:len(p):len(p)], '\n')
+	}
+
+	if _, err := config().raw.Write(p); err != nil {
+		writeError(err)
+	}
+}
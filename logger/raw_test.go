@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRaw(t *testing.T) {
+	t.Run("Raw when line has no newline should write it unaltered with a newline", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+		})
+
+		Raw([]byte(`subsystem: ready {not json}`))
+
+		assert.Equal(t, "subsystem: ready {not json}\n", buff.String())
+	})
+
+	t.Run("Raw when line ends with a newline should not append another", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+		})
+
+		Raw([]byte("rendered\n"))
+
+		assert.Equal(t, "rendered\n", buff.String())
+	})
+
+	t.Run("Raw when format is console should bypass the encoding", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithFormat(FormatConsole)
+		})
+
+		Info(context.TODO()).Msg("structured")
+		Raw([]byte(`{"level":"info","message":"rendered"}`))
+
+		lines := bytes.Split(bytes.TrimSuffix(buff.Bytes(), []byte("\n")), []byte("\n"))
+		assert.Len(t, lines, 2)
+		assert.Contains(t, string(lines[0]), "structured")
+		assert.Equal(t, `{"level":"info","message":"rendered"}`, string(lines[1]))
+	})
+}
@@ -0,0 +1,144 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"github.com/rs/zerolog"
+)
+
+// recordOption represents a function that modifies a JSON rendered log event at write time.
+type recordOption func(r *record)
+
+type field struct {
+	key   string
+	value json.RawMessage
+}
+
+// record is a JSON rendered log event decoded into its top-level fields, preserving their order.
+type record struct {
+	level  zerolog.Level
+	fields []field
+	buf    *bytes.Buffer // Buffer of the encoded record, reused with the record when pooling is enabled.
+}
+
+func decodeRecord(level zerolog.Level, p []byte) (*record, error) {
+	dec := json.NewDecoder(bytes.NewReader(p))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("logger: log event is not a JSON object")
+	}
+
+	r := acquireRecord(level)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			releaseRecord(r)
+			return nil, err
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			releaseRecord(r)
+			return nil, err
+		}
+
+		r.fields = append(r.fields, field{key: tok.(string), value: value})
+	}
+
+	return r, nil
+}
+
+// encode renders the record as a JSON object. The returned bytes are only valid until the record is released.
+func (r *record) encode() []byte {
+	if r.buf == nil {
+		r.buf = &bytes.Buffer{}
+	}
+	buf := r.buf
+	buf.Reset()
+	buf.WriteByte('{')
+
+	for i, f := range r.fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(f.key)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(f.value)
+	}
+
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+func (r *record) get(key string) (json.RawMessage, bool) {
+	for _, f := range r.fields {
+		if f.key == key {
+			return f.value, true
+		}
+	}
+	return nil, false
+}
+
+// str returns the value of the field when it is a string.
+func (r *record) str(key string) (string, bool) {
+	value, ok := r.get(key)
+	if !ok {
+		return "", false
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+// set replaces the value of the field, appending it when missing.
+func (r *record) set(key string, value any) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+
+	for i, f := range r.fields {
+		if f.key == key {
+			r.fields[i].value = raw
+			return
+		}
+	}
+	r.fields = append(r.fields, field{key: key, value: raw})
+}
+
+// recordWriter applies the record options to each JSON rendered log event before writing it.
+// Events that are not JSON objects are written unchanged.
+type recordWriter struct {
+	w    zerolog.LevelWriter
+	opts []recordOption
+}
+
+func (w *recordWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *recordWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	r, err := decodeRecord(level, p)
+	if err != nil {
+		return w.w.WriteLevel(level, p)
+	}
+	defer releaseRecord(r)
+
+	for _, opt := range w.opts {
+		opt(r)
+	}
+
+	if _, err := w.w.WriteLevel(level, r.encode()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *recordWriter) Sync() error {
+	return syncWriter(w.w)
+}
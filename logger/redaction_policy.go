@@ -0,0 +1,121 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+type redactionCtxKey struct{}
+
+// WithRedactionPolicy stores a logger masking the given keys into the context, derived from the context logger,
+// so the fields with those keys are replaced by "[REDACTED]", at write time, on every log event created with the returned context,
+// including nested fields and the ones created directly with the logger returned by FromContext.
+// It allows the redacted keys to be chosen per request, such as by tenant, on top of the options applied to every log event.
+// The keys add up to the ones of the policies inherited from the parent context, and are not shared with other contexts.
+// Since WithContext starts from the global logger, it must not be called after WithRedactionPolicy on the same context.
+//
+// Example usage:
+//
+//	ctx = logger.WithRedactionPolicy(ctx, tenant.RedactedFields) // []string{"email", "tax_id"}
+//	logger.Info(ctx).Str("email", user.Email).Msg("user updated") // "email":"[REDACTED]"
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the parent logger.
+//	keys ([]string): The keys of the redacted fields.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the logger with the redaction policy.
+func WithRedactionPolicy(ctx context.Context, keys []string) context.Context {
+	if len(keys) == 0 {
+		return ctx
+	}
+
+	inherited, _ := ctx.Value(redactionCtxKey{}).(map[string]struct{})
+	policy := make(map[string]struct{}, len(inherited)+len(keys))
+	for key := range inherited {
+		policy[key] = struct{}{}
+	}
+	for _, key := range keys {
+		policy[key] = struct{}{}
+	}
+
+	return withOutput(context.WithValue(ctx, redactionCtxKey{}, policy), func(w io.Writer) io.Writer {
+		return &redactionWriter{w: w, keys: policy}
+	})
+}
+
+// redactionWriter masks the fields with the policy keys of each JSON rendered log event before writing it.
+// Events that are not JSON objects are written unchanged.
+type redactionWriter struct {
+	w    io.Writer
+	keys map[string]struct{}
+}
+
+func (w *redactionWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *redactionWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	r, err := decodeRecord(level, p)
+	if err != nil {
+		return writeLevel(w.w, level, p)
+	}
+	defer releaseRecord(r)
+
+	for i, f := range r.fields {
+		if _, ok := w.keys[f.key]; ok {
+			r.fields[i].value = json.RawMessage(`"` + secretMask + `"`)
+			continue
+		}
+		if len(f.value) == 0 || (f.value[0] != '{' && f.value[0] != '[') {
+			continue
+		}
+
+		value, err := decodeValue(f.value)
+		if err != nil || !redactKeys(value, w.keys) {
+			continue
+		}
+		if raw, err := json.Marshal(value); err == nil {
+			r.fields[i].value = raw
+		}
+	}
+
+	if _, err := writeLevel(w.w, level, r.encode()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *redactionWriter) Sync() error {
+	return syncWriter(w.w)
+}
+
+// redactKeys masks, in place, the nested fields with the given keys, reporting whether any was masked.
+func redactKeys(value any, keys map[string]struct{}) bool {
+	masked := false
+
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if _, ok := keys[key]; ok {
+				v[key] = secretMask
+				masked = true
+			} else if redactKeys(item, keys) {
+				masked = true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if redactKeys(item, keys) {
+				masked = true
+			}
+		}
+	}
+
+	return masked
+}
@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRedactionPolicy(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithSecretScanning()
+	})
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	tenantA := WithRedactionPolicy(context.TODO(), []string{"email"})
+	tenantB := WithRedactionPolicy(context.TODO(), []string{"phone"})
+
+	t.Run("WithRedactionPolicy when key is in the policy should mask the field", func(t *testing.T) {
+		Info(tenantA).Str("email", "jane@example.com").Str("phone", "555-0100").Msg("user updated")
+
+		f := fields()
+		assert.Equal(t, "[REDACTED]", f["email"])
+		assert.Equal(t, "555-0100", f["phone"])
+	})
+
+	t.Run("WithRedactionPolicy when key is in another context policy should not mask the field", func(t *testing.T) {
+		Info(tenantB).Str("email", "jane@example.com").Msg("user updated")
+
+		assert.Equal(t, "jane@example.com", fields()["email"])
+	})
+
+	t.Run("WithRedactionPolicy when key is nested should mask the nested field", func(t *testing.T) {
+		Info(tenantA).Dict("user", zerolog.Dict().Str("email", "jane@example.com").Str("name", "Jane")).Msg("user updated")
+
+		assert.Equal(t, map[string]any{"email": "[REDACTED]", "name": "Jane"}, fields()["user"])
+	})
+
+	t.Run("WithRedactionPolicy when policies are nested should mask the inherited keys", func(t *testing.T) {
+		ctx := WithRedactionPolicy(tenantA, []string{"phone"})
+		Info(ctx).Str("email", "jane@example.com").Str("phone", "555-0100").Msg("user updated")
+
+		f := fields()
+		assert.Equal(t, "[REDACTED]", f["email"])
+		assert.Equal(t, "[REDACTED]", f["phone"])
+	})
+
+	t.Run("WithRedactionPolicy when global redaction is enabled should apply both", func(t *testing.T) {
+		Info(tenantA).Str("email", "jane@example.com").Str("card", "4111 1111 1111 1111").Msg("payment")
+
+		f := fields()
+		assert.Equal(t, "[REDACTED]", f["email"])
+		assert.Equal(t, "[REDACTED]", f["card"])
+		assert.Equal(t, true, f["secret_detected"])
+	})
+
+	t.Run("Info when context does not carry a policy should not mask the field", func(t *testing.T) {
+		Info(context.TODO()).Str("email", "jane@example.com").Msg("user updated")
+
+		assert.Equal(t, "jane@example.com", fields()["email"])
+	})
+}
@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// WithReplaceField calls fn for every field of every log event at write time, including level, time and message,
+// allowing fields to be renamed, transformed or dropped before being written. Returning false drops the field.
+// Values are decoded from JSON, so numbers are json.Number, objects are map[string]any and arrays are []any.
+//
+// Since each event is decoded and encoded again, this option adds a noticeable cost per event.
+// Prefer the narrower options, such as WithIPObfuscation, when they fit.
+//
+// Example usage:
+//
+//	cfg.WithReplaceField(func(key string, value any) (string, any, bool) {
+//	    switch key {
+//	    case "password":
+//	        return key, nil, false // Drops the field.
+//	    case "msg":
+//	        return "message", value, true // Renames the field.
+//	    }
+//	    return key, value, true
+//	})
+//
+// Params:
+//
+//	fn (func(key string, value any) (string, any, bool)): The function returning the replaced key and value, and whether the field is kept.
+func (cfg *LoggerConfig) WithReplaceField(fn func(key string, value any) (string, any, bool)) {
+	cfg.recordOptions = append(cfg.recordOptions, func(r *record) {
+		fields := make([]field, 0, len(r.fields))
+
+		for _, f := range r.fields {
+			value, err := decodeValue(f.value)
+			if err != nil {
+				fields = append(fields, f)
+				continue
+			}
+
+			key, value, keep := fn(f.key, value)
+			if !keep {
+				continue
+			}
+
+			raw, err := json.Marshal(value)
+			if err != nil {
+				raw = f.value
+			}
+			fields = append(fields, field{key: key, value: raw})
+		}
+
+		r.fields = fields
+	})
+}
+
+func decodeValue(raw json.RawMessage) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var value any
+	err := dec.Decode(&value)
+	return value, err
+}
@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithReplaceField(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithReplaceField(func(key string, value any) (string, any, bool) {
+			switch key {
+			case "usr":
+				return "user_id", value, true
+			case "password":
+				return key, nil, false
+			case "email":
+				return key, strings.ToUpper(value.(string)), true
+			case "amount":
+				n, _ := value.(json.Number).Int64()
+				return key, n * 100, true
+			}
+			return key, value, true
+		})
+	})
+
+	Info(context.TODO()).Str("usr", "42").Str("password", "secret").Str("email", "a@b.c").Int("amount", 12).Msg("replaced")
+	msg := buff.String()
+
+	t.Run("WithReplaceField when key is replaced should rename the field", func(t *testing.T) {
+		assert.Contains(t, msg, "\"user_id\":\"42\"")
+		assert.NotContains(t, msg, "\"usr\"")
+	})
+
+	t.Run("WithReplaceField when field is not kept should drop the field", func(t *testing.T) {
+		assert.NotContains(t, msg, "password")
+		assert.NotContains(t, msg, "secret")
+	})
+
+	t.Run("WithReplaceField when value is replaced should transform the field", func(t *testing.T) {
+		assert.Contains(t, msg, "\"email\":\"A@B.C\"")
+		assert.Contains(t, msg, "\"amount\":1200")
+	})
+
+	t.Run("WithReplaceField when field is returned unchanged should keep the field", func(t *testing.T) {
+		assert.Contains(t, msg, "\"level\":\"info\"")
+		assert.Contains(t, msg, "\"message\":\"replaced\"")
+	})
+}
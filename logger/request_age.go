@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type startCtxKey struct{}
+
+// MarkStart stores the current time into the context as the start of the request,
+// so the RequestAgeField event option writes how long the request has been running on every log event
+// created with the returned context. The time is read from the monotonic clock, so the age is not affected by
+// wall clock changes. HTTPMiddleware marks the start before calling the wrapped handler.
+//
+// Example usage:
+//
+//	ctx = logger.MarkStart(ctx)
+//	logger.Info(ctx).Msg("payment authorized") // "request_age_ms":12.5
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the start time is stored.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the start time.
+func MarkStart(ctx context.Context) context.Context {
+	return withStart(ctx, time.Now())
+}
+
+func withStart(ctx context.Context, start time.Time) context.Context {
+	return context.WithValue(ctx, startCtxKey{}, start)
+}
+
+// RequestAgeField returns an event option that writes the time elapsed since the start stored in the context by MarkStart
+// as the 'request_age_ms' field, in milliseconds. Log events created with a context without a start time are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.RequestAgeField())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the request age field.
+func RequestAgeField() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		start, ok := ctx.Value(startCtxKey{}).(time.Time)
+		if !ok {
+			return e
+		}
+		return e.Float64("request_age_ms", float64(time.Since(start))/float64(time.Millisecond))
+	}
+}
@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestAgeField(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(RequestAgeField())
+	})
+
+	age := func() any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields["request_age_ms"]
+	}
+
+	t.Run("RequestAgeField when start is marked should write an increasing age", func(t *testing.T) {
+		ctx := MarkStart(context.TODO())
+
+		Info(ctx).Msg("first")
+		first := age()
+		time.Sleep(10 * time.Millisecond)
+		Info(ctx).Msg("second")
+		second := age()
+
+		assert.GreaterOrEqual(t, first, float64(0))
+		assert.GreaterOrEqual(t, second, first.(float64)+10)
+	})
+
+	t.Run("RequestAgeField when context does not carry a start should not write the request age field", func(t *testing.T) {
+		Info(context.TODO()).Msg("test")
+
+		assert.Nil(t, age())
+	})
+
+	t.Run("HTTPMiddleware when request is served should mark the start", func(t *testing.T) {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Info(r.Context()).Msg("handled")
+		})
+
+		HTTPMiddleware()(handler).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+		line := bytes.SplitN(buff.Bytes(), []byte("\n"), 2)[0]
+		buff.Reset()
+		assert.Contains(t, string(line), "\"request_age_ms\":")
+	})
+}
@@ -0,0 +1,188 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+type requestBufferConfig struct {
+	maxEvents    int
+	flushOnError bool
+}
+
+// WithPerRequestBuffering makes the HTTP middleware hold the log events of each request in memory, including the request log,
+// and write them as a contiguous block, in order, once the request completes, so the logs of concurrent requests are not interleaved.
+// At most maxEvents log events are held, and the block is written early when the cap is reached.
+// When flushOnError is set, events at the "error" level or above write the held block right away, while fatal events always do.
+// Log events written in the background by WithBufferedWriter may still be interleaved.
+//
+// Example usage:
+//
+//	cfg.WithPerRequestBuffering(500, true)
+//	handler := logger.HTTPMiddleware()(mux)
+//
+// Params:
+//
+//	maxEvents (int): The maximum number of log events held per request.
+//	flushOnError (bool): Whether error events write the held block right away.
+func (cfg *LoggerConfig) WithPerRequestBuffering(maxEvents int, flushOnError bool) {
+	cfg.requestBuffer = &requestBufferConfig{maxEvents: maxEvents, flushOnError: flushOnError}
+}
+
+// bufferRequest stores a logger holding the log events of the request into the context,
+// returning the function writing the held log events once the request completes.
+func bufferRequest(ctx context.Context, c *requestBufferConfig) (context.Context, func()) {
+	b := &requestBuffer{cfg: c, block: config().block}
+
+	ctx = withOutput(ctx, func(w io.Writer) io.Writer {
+		return &requestBufferWriter{w: w, b: b}
+	})
+
+	return ctx, b.close
+}
+
+type bufferedEvent struct {
+	w     io.Writer
+	level zerolog.Level
+	p     []byte
+}
+
+// requestBuffer holds the log events of a request, written through the writer of the logger that created each of them.
+type requestBuffer struct {
+	cfg      *requestBufferConfig
+	block    *blockLock
+	mu       sync.Mutex
+	flushing sync.Mutex // Held while a block is written, so the blocks of the request are written in order.
+	events   []bufferedEvent
+	closed   bool
+}
+
+func (b *requestBuffer) write(w io.Writer, level zerolog.Level, p []byte) (int, error) {
+	b.mu.Lock()
+
+	if b.closed {
+		b.mu.Unlock()
+		return writeLevel(w, level, p)
+	}
+
+	// The event buffer is reused by zerolog once the write returns.
+	b.events = append(b.events, bufferedEvent{w: w, level: level, p: bytes.Clone(p)})
+
+	immediate := level >= zerolog.FatalLevel || (b.cfg.flushOnError && level >= zerolog.ErrorLevel)
+	if !immediate && len(b.events) < b.cfg.maxEvents {
+		b.mu.Unlock()
+		return len(p), nil
+	}
+
+	// The program exits once a fatal event is written, so the log events written meanwhile, such as by the callbacks
+	// registered with WithBeforeExit, are written right away.
+	if level >= zerolog.FatalLevel {
+		b.closed = true
+	}
+	b.flush()
+
+	return len(p), nil
+}
+
+// flush writes the held log events as a contiguous block. It must be called with the lock held, which it releases
+// before writing, so the log events written meanwhile, such as by the exit callbacks of a fatal event, do not wait on it.
+func (b *requestBuffer) flush() {
+	events := b.events
+	b.events = nil
+
+	b.flushing.Lock()
+	defer b.flushing.Unlock()
+	b.mu.Unlock()
+
+	if len(events) == 0 {
+		return
+	}
+
+	b.block.hold()
+	defer b.block.release()
+
+	for _, e := range events {
+		if _, err := writeLevel(e.w, e.level, e.p); err != nil {
+			writeError(err)
+		}
+	}
+}
+
+func (b *requestBuffer) close() {
+	b.mu.Lock()
+	b.closed = true
+	b.flush()
+}
+
+type requestBufferWriter struct {
+	w io.Writer
+	b *requestBuffer
+}
+
+func (w *requestBufferWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *requestBufferWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	return w.b.write(w.w, level, p)
+}
+
+func (w *requestBufferWriter) Sync() error {
+	return syncWriter(w.w)
+}
+
+// blockLock serializes the writes to the output destinations, so a goroutine holding it writes a block of log events
+// that is not interleaved with the log events written by other goroutines.
+type blockLock struct {
+	mu    sync.Mutex
+	owner atomic.Uint64 // Goroutine holding the lock, or zero.
+}
+
+func (l *blockLock) hold() {
+	l.mu.Lock()
+	l.owner.Store(goroutineID())
+}
+
+func (l *blockLock) release() {
+	l.owner.Store(0)
+	l.mu.Unlock()
+}
+
+// blocked returns w written under the block lock, when WithPerRequestBuffering is set.
+// Every output destination is written through it, so no destination is left out of the blocks.
+func (cfg *LoggerConfig) blocked(w io.Writer) io.Writer {
+	if cfg.block == nil {
+		return w
+	}
+	return &blockWriter{w: w, lock: cfg.block}
+}
+
+// blockWriter writes to an output destination under the block lock.
+type blockWriter struct {
+	w    io.Writer
+	lock *blockLock
+}
+
+func (w *blockWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *blockWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	// The goroutines are only identified while a block is being written, since it is costly.
+	if owner := w.lock.owner.Load(); owner != 0 && owner == goroutineID() {
+		return writeLevel(w.w, level, p)
+	}
+
+	w.lock.mu.Lock()
+	defer w.lock.mu.Unlock()
+	return writeLevel(w.w, level, p)
+}
+
+func (w *blockWriter) Sync() error {
+	return syncWriter(w.w)
+}
@@ -0,0 +1,186 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+var messageField = regexp.MustCompile(`"message":"([^"]*)"`)
+
+// messages returns the messages of the log events written to the buffer, in order.
+func messages(s string) []string {
+	msgs := []string{}
+	for _, m := range messageField.FindAllStringSubmatch(s, -1) {
+		msgs = append(msgs, m[1])
+	}
+	return msgs
+}
+
+func TestWithPerRequestBuffering(t *testing.T) {
+	t.Run("WithPerRequestBuffering when requests log concurrently should write each request as a contiguous block", func(t *testing.T) {
+		buff := &lockedBuffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithPerRequestBuffering(100, false)
+		})
+
+		turns := map[string]chan struct{}{"a": make(chan struct{}), "b": make(chan struct{})}
+		handler := HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.URL.Query().Get("id")
+			if id == "b" {
+				<-turns["b"]
+			}
+			Info(r.Context()).Msg(id + "1")
+			if id == "a" {
+				close(turns["b"])
+				<-turns["a"]
+			} else {
+				close(turns["a"])
+			}
+			Info(r.Context()).Msg(id + "2")
+		}))
+
+		wg := sync.WaitGroup{}
+		for _, id := range []string{"a", "b"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders?id="+id, nil))
+			}()
+		}
+		wg.Wait()
+
+		msgs := strings.Join(messages(buff.String()), ",")
+		assert.Contains(t, []string{
+			"a1,a2,http request,b1,b2,http request",
+			"b1,b2,http request,a1,a2,http request",
+		}, msgs)
+	})
+
+	t.Run("WithPerRequestBuffering when cap is reached should write the held events early", func(t *testing.T) {
+		buff := &lockedBuffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithPerRequestBuffering(2, false)
+		})
+
+		var written []string
+		handler := HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, msg := range []string{"first", "second", "third"} {
+				Info(r.Context()).Msg(msg)
+			}
+			written = messages(buff.String())
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders", nil))
+
+		assert.Equal(t, []string{"first", "second"}, written)
+		assert.Equal(t, []string{"first", "second", "third", "http request"}, messages(buff.String()))
+	})
+
+	t.Run("WithPerRequestBuffering when flushing on error should write the held events with the error", func(t *testing.T) {
+		buff := &lockedBuffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithPerRequestBuffering(100, true)
+		})
+
+		var written []string
+		handler := HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Info(r.Context()).Msg("charging")
+			Err(r.Context(), errors.New("declined")).Msg("charge failed")
+			written = messages(buff.String())
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders", nil))
+
+		assert.Equal(t, []string{"charging", "charge failed"}, written)
+	})
+
+	t.Run("WithPerRequestBuffering when request completed should write later events directly", func(t *testing.T) {
+		buff := &lockedBuffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithPerRequestBuffering(100, false)
+		})
+
+		var ctx context.Context
+		handler := HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+		}))
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders", nil))
+
+		Info(ctx).Msg("background")
+
+		assert.Equal(t, []string{"http request", "background"}, messages(buff.String()))
+	})
+
+	t.Run("WithPerRequestBuffering when level range writers are set should hold their writes while a block is written", func(t *testing.T) {
+		buff := &lockedBuffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriterForLevelRange(zerolog.TraceLevel, zerolog.PanicLevel, buff)
+			cfg.WithPerRequestBuffering(100, false)
+		})
+
+		config().block.hold()
+		done := make(chan struct{})
+		go func() {
+			Info(context.TODO()).Msg("outside")
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("write was not held by the block")
+		case <-time.After(20 * time.Millisecond):
+		}
+		config().block.release()
+		<-done
+
+		assert.Equal(t, []string{"outside"}, messages(buff.String()))
+	})
+
+	t.Run("WithPerRequestBuffering when an exit callback logs with the request context should not deadlock", func(t *testing.T) {
+		buff := &lockedBuffer{}
+		var ctx context.Context
+		exited := make(chan int, 1)
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithPerRequestBuffering(100, false)
+			cfg.WithBeforeExit(func() {
+				Info(ctx).Msg("closing")
+			})
+			cfg.WithExitFunc(func(code int) { exited <- code })
+		})
+
+		handler := HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+			Info(ctx).Msg("started")
+			FatalCode(ctx, 2).Msg("unrecoverable")
+		}))
+
+		done := make(chan struct{})
+		go func() {
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders", nil))
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("fatal event deadlocked")
+		}
+		assert.Equal(t, 2, <-exited)
+		assert.Equal(t, []string{"started", "unrecoverable", "closing"}, messages(buff.String())[:3])
+	})
+}
@@ -0,0 +1,29 @@
+package logger
+
+// Reset restores the package to its default state, as if Configure was never called: the default logger writing
+// FormatJSON log events to os.Stdout at the "trace" level, without options. The writers opened by the previous
+// configuration, such as compressed files, are closed, and the counters, such as Dropped and the process-wide
+// sequence of WithSequence, start over. Contexts created before Reset keep their stored loggers.
+// It is meant to isolate tests, from TestMain or t.Cleanup, and like Configure it is safe to call while logging:
+// the log events created concurrently are written with either configuration.
+//
+// Example usage:
+//
+//	t.Cleanup(logger.Reset)
+func Reset() {
+	configureMu.Lock()
+	defer configureMu.Unlock()
+
+	configure()
+
+	dropped.Store(0)
+	malformedURLs.Store(0)
+	invalidIPs.Store(0)
+	missingPlaceholders.Store(0)
+	globalSequence.Store(0)
+
+	callerFrames.Range(func(key, _ any) bool {
+		callerFrames.Delete(key)
+		return true
+	})
+}
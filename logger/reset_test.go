@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReset(t *testing.T) {
+	t.Run("Configure when a case configures the logger should apply its configuration", func(t *testing.T) {
+		t.Cleanup(Reset)
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithLevel(zerolog.WarnLevel)
+			cfg.WithContextFields(func(c zerolog.Context) zerolog.Context {
+				return c.Str("service", "payment-service")
+			})
+		})
+
+		Info(context.TODO()).Msg("discarded")
+		Warn(context.TODO()).Msg("written")
+
+		assert.NotContains(t, buff.String(), "discarded")
+		assert.Contains(t, buff.String(), `"service":"payment-service"`)
+	})
+
+	t.Run("Reset when the logger was configured should restore the defaults", func(t *testing.T) {
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(&bytes.Buffer{})
+			cfg.WithLevel(zerolog.WarnLevel)
+			cfg.WithContextFields(func(c zerolog.Context) zerolog.Context {
+				return c.Str("service", "payment-service")
+			})
+		})
+		WithFormat(context.TODO(), FormatConsole)
+		globalSequence.Add(1)
+		dropped.Add(1)
+
+		Reset()
+
+		cfg := config()
+		assert.Equal(t, os.Stdout, cfg.w)
+		assert.Equal(t, FormatJSON, cfg.format)
+		assert.Empty(t, cfg.ctxFields)
+		assert.Empty(t, cfg.hooks)
+		assert.Equal(t, zerolog.TraceLevel, GetLevel())
+		assert.Empty(t, cfg.formatOuts)
+		assert.Zero(t, globalSequence.Load())
+		assert.Zero(t, Dropped())
+	})
+
+	t.Run("Reset when called while logging should not race", func(t *testing.T) {
+		t.Cleanup(Reset)
+		buff := &lockedBuffer{}
+		stop := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+						Info(WithContext(context.TODO())).Msg("concurrent")
+					}
+				}
+			}()
+		}
+
+		for i := 0; i < 20; i++ {
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+			})
+			Reset()
+		}
+		close(stop)
+		wg.Wait()
+	})
+}
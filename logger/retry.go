@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Retry starts a new logging event describing an attempt of a retried operation.
+// Failed attempts are logged at the "warn" level with the 'attempt', 'max_attempts', 'next_delay_ms' and 'error' fields,
+// or at the "error" level, without 'next_delay_ms', once the attempts are exhausted.
+// When err is nil, the operation succeeded and it is logged at the "info" level with the 'attempts' field.
+// It returns a *zerolog.Event that is not sent until the Msg method is called.
+//
+// Example usage:
+//
+//	logger.Retry(ctx, attempt, 5, backoff, err).Msg("calling payment provider")
+//
+// Params:
+//
+//	ctx (context.Context): The context from which to extract tracing information.
+//	attempt (int): The current attempt, starting at 1.
+//	maxAttempts (int): The maximum number of attempts.
+//	nextDelay (time.Duration): The delay before the next attempt.
+//	err (error): The error of the attempt, or nil if it succeeded.
+//
+// Returns:
+//
+//	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
+func Retry(ctx context.Context, attempt, maxAttempts int, nextDelay time.Duration, err error) *zerolog.Event {
+	if err == nil {
+		e := newEvent(ctx, zerolog.InfoLevel).Int("attempts", attempt)
+
+		return event(ctx, e)
+	}
+
+	exhausted := attempt >= maxAttempts
+
+	level := zerolog.WarnLevel
+	if exhausted {
+		level = zerolog.ErrorLevel
+	}
+
+	e := errEvent(ctx, newEvent(ctx, level), err).Int("attempt", attempt).Int("max_attempts", maxAttempts)
+
+	if !exhausted {
+		e = e.Int64("next_delay_ms", nextDelay.Milliseconds())
+	}
+
+	return event(ctx, e)
+}
@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry(t *testing.T) {
+	suts := map[string]struct {
+		act    func(ctx context.Context)
+		assert func(t *testing.T, msg string)
+	}{
+		"Retry when attempt fails should log a warning with the next delay": {
+			act: func(ctx context.Context) {
+				Retry(ctx, 2, 5, 200*time.Millisecond, errors.New("timeout")).Msg("retrying")
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"level\":\"warn\"")
+				assert.Contains(t, msg, "\"error\":\"timeout\"")
+				assert.Contains(t, msg, "\"attempt\":2")
+				assert.Contains(t, msg, "\"max_attempts\":5")
+				assert.Contains(t, msg, "\"next_delay_ms\":200")
+			},
+		},
+		"Retry when attempts are exhausted should log an error": {
+			act: func(ctx context.Context) {
+				Retry(ctx, 5, 5, 200*time.Millisecond, errors.New("timeout")).Msg("retrying")
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"level\":\"error\"")
+				assert.Contains(t, msg, "\"error\":\"timeout\"")
+				assert.Contains(t, msg, "\"attempt\":5")
+				assert.NotContains(t, msg, "next_delay_ms")
+			},
+		},
+		"Retry when attempt succeeds should log the number of attempts": {
+			act: func(ctx context.Context) {
+				Retry(ctx, 3, 5, 0, nil).Msg("retrying")
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"level\":\"info\"")
+				assert.Contains(t, msg, "\"attempts\":3")
+				assert.NotContains(t, msg, "error")
+			},
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+			})
+
+			sut.act(context.TODO())
+
+			sut.assert(t, buff.String())
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package logger
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+type roundTripperConfig struct {
+	correlationHeader string // Outbound request header receiving the correlation chain.
+}
+
+// RoundTripperOption represents a function that modifies the logging round tripper configuration.
+type RoundTripperOption func(cfg *roundTripperConfig)
+
+// WithPropagateCorrelation makes the logging round tripper set the given outbound request header to the correlation chain
+// of the request context, returned by CorrelationChain as comma-separated IDs, so the downstream service continues the lineage,
+// such as through the WithCorrelationChain middleware option. Headers already set on the request are not overwritten,
+// and requests whose context carries no correlation chain are not changed.
+//
+// Example usage:
+//
+//	client := &http.Client{Transport: logger.NewLoggingRoundTripper(nil, logger.WithPropagateCorrelation("X-Correlation-Chain"))}
+//
+// Params:
+//
+//	header (string): The outbound request header receiving the correlation chain.
+//
+// Returns:
+//
+//	RoundTripperOption: The option to be passed to NewLoggingRoundTripper.
+func WithPropagateCorrelation(header string) RoundTripperOption {
+	return func(cfg *roundTripperConfig) {
+		cfg.correlationHeader = header
+	}
+}
+
+// NewLoggingRoundTripper returns a round tripper logging every outbound request once the response is received,
+// with the request context and the 'method', 'url', 'status' and 'duration_ms' fields. The URL is sanitized by SanitizeURL.
+// Requests are logged at the "error" level for 5xx responses and transport errors, "warn" for 4xx responses and "info" otherwise.
+//
+// Example usage:
+//
+//	client := &http.Client{Transport: logger.NewLoggingRoundTripper(http.DefaultTransport)}
+//
+// Params:
+//
+//	next (http.RoundTripper): The round tripper sending the requests, or nil to use http.DefaultTransport.
+//	opts (...logger.RoundTripperOption): Optional functions that modifies the round tripper configuration.
+//
+// Returns:
+//
+//	http.RoundTripper: The round tripper logging the outbound requests.
+func NewLoggingRoundTripper(next http.RoundTripper, opts ...RoundTripperOption) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	rcfg := &roundTripperConfig{}
+	for _, opt := range opts {
+		opt(rcfg)
+	}
+
+	return &loggingRoundTripper{next: next, cfg: rcfg}
+}
+
+type loggingRoundTripper struct {
+	next http.RoundTripper
+	cfg  *roundTripperConfig
+}
+
+func (t *loggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.cfg.correlationHeader != "" && req.Header.Get(t.cfg.correlationHeader) == "" {
+		if chain := CorrelationChain(ctx); len(chain) > 0 {
+			// Round trippers must not modify the given request.
+			req = req.Clone(ctx)
+			req.Header.Set(t.cfg.correlationHeader, strings.Join(chain, ","))
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	level := statusLevel(http.StatusInternalServerError)
+	if err == nil {
+		level = statusLevel(resp.StatusCode)
+	}
+
+	e := errEvent(ctx, newEvent(ctx, level), err).
+		Str("method", req.Method).
+		Str("url", SanitizeURL(req.URL.String()))
+	if err == nil {
+		e = e.Int("status", resp.StatusCode)
+	}
+	e = e.Float64("duration_ms", float64(elapsed)/float64(time.Millisecond))
+
+	event(ctx, e).Msg("http client request")
+
+	return resp, err
+}
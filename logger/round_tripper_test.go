@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewLoggingRoundTripper(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	ctx := WithRequestID(WithUpstream(context.TODO(), "edge-1"), "r-42")
+
+	t.Run("NewLoggingRoundTripper when propagating correlation should set the header and log the call", func(t *testing.T) {
+		buff.Reset()
+		client := &http.Client{Transport: NewLoggingRoundTripper(nil, WithPropagateCorrelation("X-Correlation-Chain"))}
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/users?token=s3cr3t", nil)
+
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, "edge-1,r-42", received.Get("X-Correlation-Chain"))
+		assert.Empty(t, req.Header.Get("X-Correlation-Chain"))
+		assert.Contains(t, buff.String(), `"level":"warn"`)
+		assert.Contains(t, buff.String(), `"method":"GET"`)
+		assert.Contains(t, buff.String(), `/users?token=[REDACTED]"`)
+		assert.Contains(t, buff.String(), `"status":404`)
+		assert.Contains(t, buff.String(), `"message":"http client request"`)
+	})
+
+	t.Run("NewLoggingRoundTripper when header is already set should not overwrite it", func(t *testing.T) {
+		client := &http.Client{Transport: NewLoggingRoundTripper(nil, WithPropagateCorrelation("X-Correlation-Chain"))}
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		req.Header.Set("X-Correlation-Chain", "explicit")
+
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, "explicit", received.Get("X-Correlation-Chain"))
+	})
+
+	t.Run("NewLoggingRoundTripper when transport fails should log the error", func(t *testing.T) {
+		buff.Reset()
+		rt := NewLoggingRoundTripper(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		}))
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://payments.internal/charge", nil)
+
+		_, err := rt.RoundTrip(req)
+
+		assert.EqualError(t, err, "connection refused")
+		assert.Contains(t, buff.String(), `"level":"error","error":"connection refused"`)
+		assert.NotContains(t, buff.String(), `"status"`)
+	})
+}
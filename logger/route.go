@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+type routeCtxKey struct{}
+
+// WithRoute stores the route template of the originating HTTP request into the context, such as "/users/{id}",
+// so it is written by the RouteField event option on every log event created with the returned context,
+// including the ones created by goroutines the context is handed off to.
+// HTTPMiddleware stores the route resolved before calling the wrapped handler.
+//
+// Example usage:
+//
+//	ctx = logger.WithRoute(ctx, "/users/{id}")
+//	logger.Info(ctx).Msg("user loaded") // "route":"/users/{id}"
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the route is stored.
+//	route (string): The route template of the request.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the route.
+func WithRoute(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, routeCtxKey{}, route)
+}
+
+// RouteField returns an event option that writes the route stored in the context by WithRoute as the 'route' field.
+// Log events created with a context without a route are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.RouteField())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the route field.
+func RouteField() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		route, ok := ctx.Value(routeCtxKey{}).(string)
+		if !ok {
+			return e
+		}
+		return e.Str("route", route)
+	}
+}
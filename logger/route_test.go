@@ -0,0 +1,57 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteField(t *testing.T) {
+	buff := &lockedBuffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(RouteField())
+	})
+
+	loadUser := func(ctx context.Context) {
+		Info(ctx).Msg("user loaded")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		loadUser(r.Context())
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func(ctx context.Context) {
+			defer wg.Done()
+			Info(ctx).Msg("audit recorded")
+		}(r.Context())
+		wg.Wait()
+	})
+
+	t.Run("HTTPMiddleware when route is resolved should write the route on nested logs", func(t *testing.T) {
+		buff.Reset()
+
+		HTTPMiddleware()(mux).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42", nil))
+
+		lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+		assert.Len(t, lines, 3)
+		for _, line := range lines {
+			assert.Equal(t, 1, strings.Count(line, "\"route\":\"GET /users/{id}\""), line)
+		}
+	})
+
+	t.Run("RouteField when context does not carry a route should not write the route field", func(t *testing.T) {
+		buff.Reset()
+
+		Info(context.TODO()).Msg("no route")
+
+		assert.NotContains(t, buff.String(), "\"route\"")
+	})
+}
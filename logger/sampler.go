@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// WithBurstSamplerForLevel samples the log events of the given level with a zerolog.BurstSampler,
+// writing up to burst events per period and deferring the exceeding ones to nextSampler.
+// Levels without a configured sampler, including error and fatal, are never sampled.
+// Discarded events are counted by Dropped.
+//
+// Example usage:
+//
+//	cfg.WithBurstSamplerForLevel(zerolog.DebugLevel, 1000, time.Second, nil)
+//	cfg.WithBurstSamplerForLevel(zerolog.WarnLevel, 10, time.Second, &zerolog.BasicSampler{N: 100})
+//
+// Params:
+//
+//	level (zerolog.Level): The level of the sampled log events.
+//	burst (uint32): The number of events written per period.
+//	period (time.Duration): The period of the burst.
+//	nextSampler (zerolog.Sampler): The sampler of the events exceeding the burst, or nil to discard them.
+func (cfg *LoggerConfig) WithBurstSamplerForLevel(level zerolog.Level, burst uint32, period time.Duration, nextSampler zerolog.Sampler) {
+	if cfg.samplers == nil {
+		cfg.samplers = levelSampler{}
+	}
+	cfg.samplers[level] = &zerolog.BurstSampler{Burst: burst, Period: period, NextSampler: nextSampler}
+}
+
+// levelSampler samples the log events with the sampler configured for their level.
+type levelSampler map[zerolog.Level]zerolog.Sampler
+
+func (s levelSampler) Sample(level zerolog.Level) bool {
+	sampler, ok := s[level]
+	if !ok || sampler.Sample(level) {
+		return true
+	}
+
+	drop(DropSampled, level, "")
+	return false
+}
+
+// levelGate discards the log events below the current level, sampling the remaining ones by level.
+// The current level is lowered to override, when set, for the loggers of the contexts overriding it.
+type levelGate struct {
+	samplers levelSampler
+	override *zerolog.Level
+}
+
+func (g levelGate) Sample(level zerolog.Level) bool {
+	threshold := GetLevel()
+	if g.override != nil && *g.override < threshold {
+		threshold = *g.override
+	}
+
+	if level < threshold {
+		notifyDrop(DropFiltered, level, "")
+		return false
+	}
+	return g.samplers.Sample(level)
+}
+
+// levelHook discards the log events below the current level, as the levelGate does, since zerolog.DisableSampling
+// bypasses the samplers and so the levelGate. The "fatal" and "panic" log events are kept, so the process still exits.
+var levelHook = zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+	if level >= GetLevel() || level == zerolog.FatalLevel || level == zerolog.PanicLevel {
+		return
+	}
+	if member := config().baggageLevel; member != "" {
+		if override, ok := baggageLevel(e.GetCtx(), member); ok && level >= override {
+			return
+		}
+	}
+
+	notifyDrop(DropFiltered, level, "")
+	e.Discard()
+})
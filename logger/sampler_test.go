@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+func TestWithBurstSamplerForLevel(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithBurstSamplerForLevel(zerolog.DebugLevel, 5, time.Hour, nil)
+		cfg.WithBurstSamplerForLevel(zerolog.WarnLevel, 2, time.Hour, nil)
+	})
+	before := Dropped()
+
+	for i := 0; i < 10; i++ {
+		Debug(context.TODO()).Msg("debug message")
+		Warn(context.TODO()).Msg("warn message")
+		Error(context.TODO()).Msg("error message")
+	}
+
+	suts := map[string]struct {
+		msg   string
+		count int
+	}{
+		"WithBurstSamplerForLevel when debug events exceed the burst should sample the debug rate": {msg: "debug message", count: 5},
+		"WithBurstSamplerForLevel when warn events exceed the burst should sample the warn rate":   {msg: "warn message", count: 2},
+		"WithBurstSamplerForLevel when level has no sampler should write every event":              {msg: "error message", count: 10},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, sut.count, strings.Count(buff.String(), "\"message\":\""+sut.msg+"\""))
+		})
+	}
+
+	t.Run("WithBurstSamplerForLevel when events are sampled should count the drops", func(t *testing.T) {
+		assert.Equal(t, uint64(13), Dropped()-before)
+	})
+}
+
+func TestLevelWithSamplingDisabled(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithLevel(zerolog.WarnLevel)
+		cfg.WithBaggageLevelOverride("log.level")
+	})
+	zerolog.DisableSampling(true)
+	t.Cleanup(func() { zerolog.DisableSampling(false) })
+
+	t.Run("Info when sampling is disabled should still be filtered by the level", func(t *testing.T) {
+		buff.Reset()
+		Info(context.TODO()).Msg("info message")
+		l := FromContext(context.TODO())
+		l.Info().Msg("direct message")
+		Warn(context.TODO()).Msg("warn message")
+
+		assert.NotContains(t, buff.String(), "info message")
+		assert.NotContains(t, buff.String(), "direct message")
+		assert.Contains(t, buff.String(), "warn message")
+	})
+
+	t.Run("Debug when sampling is disabled and baggage lowers the level should be written", func(t *testing.T) {
+		buff.Reset()
+		member, err := baggage.NewMember("log.level", "debug")
+		assert.NoError(t, err)
+		b, err := baggage.New(member)
+		assert.NoError(t, err)
+		ctx := baggage.ContextWithBaggage(context.TODO(), b)
+
+		Debug(ctx).Msg("debug message")
+		l := FromContext(ctx)
+		l.Debug().Msg("direct message")
+
+		assert.Contains(t, buff.String(), "debug message")
+		assert.Contains(t, buff.String(), "direct message")
+	})
+}
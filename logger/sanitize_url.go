@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"net/url"
+	"strings"
+	"sync/atomic"
+)
+
+var malformedURLs atomic.Uint64
+
+// DefaultSensitiveParams are the query parameters always masked by SanitizeURL.
+var DefaultSensitiveParams = []string{"token", "access_token", "api_key", "apikey", "password", "secret"}
+
+// SanitizeURL masks the values of the sensitive query parameters of a URL with "[REDACTED]", so it can be safely logged.
+// The given parameters are masked in addition to DefaultSensitiveParams, and names are matched case-insensitively.
+// The other parameters are kept unaltered and in order. Malformed URLs are returned unchanged and counted by MalformedURLs.
+// The HTTP middleware applies it to the logged 'url' field.
+//
+// Example usage:
+//
+//	logger.SanitizeURL("/callback?code=abc&token=s3cr3t")           // "/callback?code=abc&token=[REDACTED]"
+//	logger.SanitizeURL("https://api.example.com/v1?sig=abc", "sig") // "https://api.example.com/v1?sig=[REDACTED]"
+//
+// Params:
+//
+//	raw (string): The URL, absolute or relative.
+//	sensitiveParams (...string): Additional query parameters to be masked.
+//
+// Returns:
+//
+//	string: The sanitized URL.
+func SanitizeURL(raw string, sensitiveParams ...string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		malformedURLs.Add(1)
+		return raw
+	}
+	if u.RawQuery == "" {
+		return raw
+	}
+
+	pairs := strings.Split(u.RawQuery, "&")
+	masked := false
+
+	for i, pair := range pairs {
+		key, _, _ := strings.Cut(pair, "=")
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			malformedURLs.Add(1)
+			return raw
+		}
+		if sensitiveParam(name, sensitiveParams) {
+			pairs[i] = key + "=" + secretMask
+			masked = true
+		}
+	}
+
+	if !masked {
+		return raw
+	}
+
+	u.RawQuery = strings.Join(pairs, "&")
+	return u.String()
+}
+
+// MalformedURLs returns the number of malformed URLs passed to SanitizeURL since the program started
+// or the last Reset.
+//
+// Returns:
+//
+//	uint64: The number of malformed URLs.
+func MalformedURLs() uint64 {
+	return malformedURLs.Load()
+}
+
+func sensitiveParam(name string, params []string) bool {
+	for _, p := range DefaultSensitiveParams {
+		if strings.EqualFold(name, p) {
+			return true
+		}
+	}
+	for _, p := range params {
+		if strings.EqualFold(name, p) {
+			return true
+		}
+	}
+	return false
+}
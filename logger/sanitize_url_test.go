@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeURL(t *testing.T) {
+	t.Run("SanitizeURL when URL has default sensitive params should mask their values", func(t *testing.T) {
+		sanitized := SanitizeURL("https://api.example.com/callback?code=abc&Token=s3cr3t&password=hunter2")
+
+		assert.Equal(t, "https://api.example.com/callback?code=abc&Token=[REDACTED]&password=[REDACTED]", sanitized)
+	})
+
+	t.Run("SanitizeURL when given sensitive params should mask them in addition to the defaults", func(t *testing.T) {
+		sanitized := SanitizeURL("/download?sig=abc&api_key=k&page=2", "sig")
+
+		assert.Equal(t, "/download?sig=[REDACTED]&api_key=[REDACTED]&page=2", sanitized)
+	})
+
+	t.Run("SanitizeURL when URL has no sensitive params should return it unchanged", func(t *testing.T) {
+		raw := "/search?q=a%20b&page=2"
+
+		assert.Equal(t, raw, SanitizeURL(raw))
+	})
+
+	t.Run("SanitizeURL when URL is malformed should return it unchanged and count it", func(t *testing.T) {
+		before := MalformedURLs()
+		raw := "http://[::1]:namedport/?token=s3cr3t"
+
+		assert.Equal(t, raw, SanitizeURL(raw))
+		assert.Equal(t, before+1, MalformedURLs())
+	})
+
+	t.Run("HTTPMiddleware when request has sensitive params should log the masked url", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+		})
+		handler := HTTPMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/callback?token=s3cr3t&state=xyz", nil))
+
+		assert.Contains(t, buff.String(), `"url":"/callback?token=[REDACTED]&state=xyz"`)
+		assert.NotContains(t, buff.String(), "s3cr3t")
+	})
+}
@@ -0,0 +1,59 @@
+package logger
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+const scopeSeparator = ">"
+
+type scopeCtxKey struct{}
+
+// PushScope appends a named scope to the breadcrumb trail stored in the context,
+// written by the ScopeField event option as the 'scope' field, such as "outer>inner>leaf".
+// Scopes are popped by using the parent context again, and sibling contexts never share their scopes.
+//
+// Example usage:
+//
+//	ctx = logger.PushScope(ctx, "checkout")
+//	ctx = logger.PushScope(ctx, "charge")
+//	logger.Info(ctx).Msg("charging") // "scope":"checkout>charge"
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the parent scopes.
+//	name (string): The name of the scope.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the scopes.
+func PushScope(ctx context.Context, name string) context.Context {
+	parent, _ := ctx.Value(scopeCtxKey{}).([]string)
+
+	scopes := make([]string, len(parent), len(parent)+1)
+	copy(scopes, parent)
+
+	return context.WithValue(ctx, scopeCtxKey{}, append(scopes, name))
+}
+
+// ScopeField returns an event option that writes the scopes stored in the context by PushScope as the 'scope' field.
+// Log events created with a context without scopes are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.ScopeField())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the scope field.
+func ScopeField() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		scopes, ok := ctx.Value(scopeCtxKey{}).([]string)
+		if !ok {
+			return e
+		}
+		return e.Str("scope", strings.Join(scopes, scopeSeparator))
+	}
+}
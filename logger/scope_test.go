@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopeField(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(ScopeField())
+	})
+	outer := PushScope(context.TODO(), "outer")
+	inner := PushScope(outer, "inner")
+	first := PushScope(inner, "first")
+	second := PushScope(inner, "second")
+
+	suts := map[string]struct {
+		ctx   context.Context
+		scope string
+	}{
+		"ScopeField when scopes are nested should write the joined path": {ctx: first, scope: "\"scope\":\"outer>inner>first\""},
+		"ScopeField when scopes are siblings should not interfere":       {ctx: second, scope: "\"scope\":\"outer>inner>second\""},
+		"ScopeField when parent context is used should pop the scopes":   {ctx: outer, scope: "\"scope\":\"outer\""},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff.Reset()
+
+			Info(sut.ctx).Msg("scoped")
+
+			assert.Contains(t, buff.String(), sut.scope)
+		})
+	}
+
+	t.Run("ScopeField when context does not carry scopes should not write the scope field", func(t *testing.T) {
+		buff.Reset()
+
+		Info(context.TODO()).Msg("unscoped")
+
+		assert.NotContains(t, buff.String(), "\"scope\":")
+	})
+}
@@ -0,0 +1,109 @@
+package logger
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+// minSecretLength is the length below which string values are not scanned for secrets.
+const minSecretLength = 13
+
+const secretMask = "[REDACTED]"
+
+var (
+	// JWTDetector matches JSON Web Tokens, such as bearer tokens. Enabled by default.
+	JWTDetector = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)
+	// CardNumberDetector matches sequences of 13 to 19 digits, optionally separated by spaces or dashes. Enabled by default.
+	CardNumberDetector = regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`)
+	// Base64Detector matches runs of 40 or more base64 characters, such as API keys. Enabled by default.
+	// Slashes are not matched, so file paths and URLs are not mistaken for secrets.
+	Base64Detector = regexp.MustCompile(`[A-Za-z0-9+]{40,}={0,2}`)
+	// HexDetector matches hexadecimal strings of 32 or more characters, such as access tokens.
+	// Disabled by default, since it also matches hashes and identifiers.
+	HexDetector = regexp.MustCompile(`\b[0-9a-fA-F]{32,}\b`)
+)
+
+// DefaultSecretDetectors are the detectors used by WithSecretScanning when none are given.
+var DefaultSecretDetectors = []*regexp.Regexp{JWTDetector, CardNumberDetector, Base64Detector}
+
+// WithSecretScanning masks, at write time, the values that look like secrets in the string fields of every log event,
+// including nested fields and the message, regardless of their keys. Matches are replaced by "[REDACTED]"
+// and the 'secret_detected' field is set to true. Strings shorter than 13 characters are not scanned.
+// The given detectors replace DefaultSecretDetectors, so HexDetector must be given explicitly to be enabled.
+//
+// Example usage:
+//
+//	cfg.WithSecretScanning() // Uses DefaultSecretDetectors.
+//	cfg.WithSecretScanning(append(logger.DefaultSecretDetectors, logger.HexDetector)...)
+//	logger.Info(ctx).Str("auth", "Bearer eyJhbGciOi...").Msg("request") // "auth":"Bearer [REDACTED]","secret_detected":true
+//
+// Params:
+//
+//	detectors (...*regexp.Regexp): The patterns matching secrets.
+func (cfg *LoggerConfig) WithSecretScanning(detectors ...*regexp.Regexp) {
+	if len(detectors) == 0 {
+		detectors = DefaultSecretDetectors
+	}
+
+	cfg.recordOptions = append(cfg.recordOptions, func(r *record) {
+		detected := false
+
+		for i, f := range r.fields {
+			if len(f.value) < minSecretLength {
+				continue
+			}
+
+			value, err := decodeValue(f.value)
+			if err != nil {
+				continue
+			}
+
+			value, masked := maskSecrets(value, detectors)
+			if !masked {
+				continue
+			}
+			if raw, err := json.Marshal(value); err == nil {
+				r.fields[i].value = raw
+				detected = true
+			}
+		}
+
+		if detected {
+			r.set("secret_detected", true)
+		}
+	})
+}
+
+func maskSecrets(value any, detectors []*regexp.Regexp) (any, bool) {
+	masked := false
+
+	switch v := value.(type) {
+	case string:
+		if len(v) < minSecretLength {
+			return v, false
+		}
+		for _, d := range detectors {
+			if d.MatchString(v) {
+				v = d.ReplaceAllString(v, secretMask)
+				masked = true
+			}
+		}
+		return v, masked
+	case map[string]any:
+		for key, item := range v {
+			if item, ok := maskSecrets(item, detectors); ok {
+				v[key] = item
+				masked = true
+			}
+		}
+	case []any:
+		for i, item := range v {
+			if item, ok := maskSecrets(item, detectors); ok {
+				v[i] = item
+				masked = true
+			}
+		}
+	}
+
+	return value, masked
+}
@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+type sequenceCtxKey struct{}
+
+// globalSequence counts the log events created with contexts without a sequence counter.
+var globalSequence atomic.Uint64
+
+// WithSequence returns an event option that writes the 'seq' field, a number incremented on each log event
+// created with the context, ordering deterministically the log events whose timestamps collide.
+// The counter is stored into the context by WithContext, so each request started with WithContext counts on its own,
+// and the contexts derived from it share it. Derive also starts a counter when the context has none.
+// The contexts that went through neither, such as context.Background(), all share a single process-wide counter,
+// so their sequences are interleaved across goroutines and only increase.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.WithSequence())
+//	ctx = logger.WithContext(ctx)
+//	logger.Info(ctx).Msg("first")  // "seq":1
+//	logger.Info(ctx).Msg("second") // "seq":2
+//	logger.Info(context.Background()).Msg("other") // "seq" from the shared process-wide counter
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the sequence field.
+func WithSequence() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		counter, ok := ctx.Value(sequenceCtxKey{}).(*atomic.Uint64)
+		if !ok {
+			counter = &globalSequence
+		}
+		return e.Uint64("seq", counter.Add(1))
+	}
+}
+
+func withSequence(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sequenceCtxKey{}, &atomic.Uint64{})
+}
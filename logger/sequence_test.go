@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSequence(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(WithSequence())
+	})
+
+	seq := func() float64 {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields["seq"].(float64)
+	}
+
+	t.Run("WithSequence when logging three times in one context should increase the sequence", func(t *testing.T) {
+		ctx := WithContext(context.TODO())
+
+		got := []float64{}
+		for range 3 {
+			Info(ctx).Msg("test")
+			got = append(got, seq())
+		}
+
+		assert.Equal(t, []float64{1, 2, 3}, got)
+	})
+
+	t.Run("WithSequence when logging in different contexts should count independently", func(t *testing.T) {
+		first, second := WithContext(context.TODO()), WithContext(context.TODO())
+
+		Info(first).Msg("test")
+		assert.Equal(t, float64(1), seq())
+		Info(first).Msg("test")
+		assert.Equal(t, float64(2), seq())
+		Info(second).Msg("test")
+		assert.Equal(t, float64(1), seq())
+	})
+
+	t.Run("WithSequence when context is derived should share the counter", func(t *testing.T) {
+		ctx := WithContext(context.TODO())
+
+		Info(ctx).Msg("test")
+		assert.Equal(t, float64(1), seq())
+		Info(Derive(ctx)).Msg("test")
+		assert.Equal(t, float64(2), seq())
+	})
+
+	t.Run("WithSequence when context without a counter is derived should start a counter", func(t *testing.T) {
+		ctx := Derive(context.TODO())
+
+		Info(ctx).Msg("test")
+		assert.Equal(t, float64(1), seq())
+		Info(Derive(ctx)).Msg("test")
+		assert.Equal(t, float64(2), seq())
+	})
+
+	t.Run("WithSequence when context does not carry a counter should increase the sequence", func(t *testing.T) {
+		Info(context.TODO()).Msg("test")
+		first := seq()
+		Info(context.TODO()).Msg("test")
+
+		assert.Greater(t, seq(), first)
+	})
+}
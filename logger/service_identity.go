@@ -0,0 +1,65 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+const sourceServiceHeader = "X-Source-Service"
+
+// WithServiceIdentity writes the 'service_name' and 'service_instance' fields on every log event,
+// recording which service and replica emitted it across a service mesh.
+// When instance is empty, the hostname is used, and the 'service_instance' field is omitted if it can not be resolved.
+//
+// Example usage:
+//
+//	cfg.WithServiceIdentity("payment-service", "") // "service_name":"payment-service","service_instance":"payment-7d9f8"
+//
+// Params:
+//
+//	name (string): The name of the service.
+//	instance (string): The identifier of the service instance, or an empty string to use the hostname.
+func (cfg *LoggerConfig) WithServiceIdentity(name, instance string) {
+	if instance == "" {
+		instance, _ = os.Hostname()
+	}
+
+	cfg.WithContextFields(func(c zerolog.Context) zerolog.Context {
+		c = c.Str("service_name", name)
+		if instance != "" {
+			c = c.Str("service_instance", instance)
+		}
+		return c
+	})
+}
+
+// WithSourceServiceHeader makes the HTTP middleware add the 'X-Source-Service' request header, set by the calling service,
+// as the 'upstream_service' field of the request logger. Missing headers are skipped, and values are truncated to 256 bytes.
+//
+// Example usage:
+//
+//	handler := logger.HTTPMiddleware(logger.WithSourceServiceHeader())(mux)
+//
+// Returns:
+//
+//	HTTPMiddlewareOption: The option to be passed to HTTPMiddleware.
+func WithSourceServiceHeader() HTTPMiddlewareOption {
+	return func(cfg *httpMiddlewareConfig) {
+		cfg.sourceService = true
+	}
+}
+
+// sourceServiceContext returns a copy of the request context whose logger carries the 'upstream_service' field.
+func sourceServiceContext(r *http.Request) context.Context {
+	source := r.Header.Get(sourceServiceHeader)
+	if source == "" {
+		return r.Context()
+	}
+
+	return Derive(r.Context(), func(c zerolog.Context) zerolog.Context {
+		return c.Str("upstream_service", truncate(source, maxHeaderTagLength))
+	})
+}
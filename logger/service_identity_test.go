@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithServiceIdentity(t *testing.T) {
+	t.Run("WithServiceIdentity when instance is given should write the identity fields", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithServiceIdentity("payment-service", "payment-1")
+		})
+
+		Info(context.TODO()).Msg("test")
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		assert.Equal(t, "payment-service", fields["service_name"])
+		assert.Equal(t, "payment-1", fields["service_instance"])
+	})
+
+	t.Run("WithServiceIdentity when instance is omitted should use the hostname", func(t *testing.T) {
+		hostname, err := os.Hostname()
+		assert.NoError(t, err)
+		buff := &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithServiceIdentity("payment-service", "")
+		})
+
+		Info(context.TODO()).Msg("test")
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		assert.Equal(t, hostname, fields["service_instance"])
+	})
+}
+
+func TestWithSourceServiceHeader(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+	handler := HTTPMiddleware(WithSourceServiceHeader())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Info(r.Context()).Msg("handling")
+	}))
+
+	t.Run("HTTPMiddleware when source service header is set should add the upstream service to the request logs", func(t *testing.T) {
+		buff.Reset()
+		r := httptest.NewRequest(http.MethodGet, "/orders", nil)
+		r.Header.Set("X-Source-Service", "checkout-service")
+
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+
+		assert.Equal(t, 2, bytes.Count(buff.Bytes(), []byte(`"upstream_service":"checkout-service"`)))
+	})
+
+	t.Run("HTTPMiddleware when source service header is missing should not add the upstream service", func(t *testing.T) {
+		buff.Reset()
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders", nil))
+
+		assert.NotContains(t, buff.String(), "upstream_service")
+	})
+}
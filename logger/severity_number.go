@@ -0,0 +1,38 @@
+package logger
+
+import "github.com/rs/zerolog"
+
+// WithSeverityNumber writes the 'severity_number' field alongside the 'level' field, the level mapped to the
+// OpenTelemetry logs SeverityNumber scale (TRACE=1, DEBUG=5, INFO=9, WARN=13, ERROR=17, FATAL=21),
+// easing the ingestion of log events by an OpenTelemetry collector. Log events without level are not changed.
+//
+// Example usage:
+//
+//	cfg.WithSeverityNumber()
+//	logger.Warn(ctx).Msg("slow query") // "level":"warn","severity_number":13
+func (cfg *LoggerConfig) WithSeverityNumber() {
+	cfg.hooks = append(cfg.hooks, zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+		if n := severityNumber(level); n > 0 {
+			e.Int("severity_number", n)
+		}
+	}))
+}
+
+func severityNumber(level zerolog.Level) int {
+	switch level {
+	case zerolog.TraceLevel:
+		return 1 // TRACE
+	case zerolog.DebugLevel:
+		return 5 // DEBUG
+	case zerolog.InfoLevel:
+		return 9 // INFO
+	case zerolog.WarnLevel:
+		return 13 // WARN
+	case zerolog.ErrorLevel:
+		return 17 // ERROR
+	case zerolog.FatalLevel, zerolog.PanicLevel:
+		return 21 // FATAL
+	default:
+		return 0 // UNSPECIFIED
+	}
+}
@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSeverityNumber(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithSeverityNumber()
+	})
+
+	t.Run("Warn when severity number is enabled should write the severity number", func(t *testing.T) {
+		buff.Reset()
+		Warn(context.TODO()).Msg("test")
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		assert.Equal(t, "warn", fields["level"])
+		assert.Equal(t, float64(13), fields["severity_number"])
+	})
+}
+
+func TestSeverityNumber(t *testing.T) {
+	numbers := map[zerolog.Level]int{
+		zerolog.TraceLevel: 1,
+		zerolog.DebugLevel: 5,
+		zerolog.InfoLevel:  9,
+		zerolog.WarnLevel:  13,
+		zerolog.ErrorLevel: 17,
+		zerolog.FatalLevel: 21,
+		zerolog.PanicLevel: 21,
+		zerolog.NoLevel:    0,
+	}
+
+	for level, number := range numbers {
+		t.Run("severityNumber when level is "+level.String()+" should map the severity number", func(t *testing.T) {
+			assert.Equal(t, number, severityNumber(level))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+type snapshotCtxKey struct{}
+
+// Snapshot accumulates the state of an operation, such as its current step, attached to log events as the 'snapshot' object.
+// Values are written in the order they were first set. A Snapshot is safe for concurrent use.
+type Snapshot struct {
+	mu     sync.Mutex
+	keys   []string
+	values map[string]any
+}
+
+// WithSnapshot stores a new, empty snapshot into the context, so every layer handling the operation can contribute to it.
+//
+// Example usage:
+//
+//	ctx = logger.WithSnapshot(ctx)
+//	logger.GetSnapshot(ctx).Set("step", "charge")
+//	logger.Err(ctx, err).Object("snapshot", logger.GetSnapshot(ctx)).Msg("checkout failed")
+//	// "snapshot":{"step":"charge"}
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the snapshot is stored.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the snapshot.
+func WithSnapshot(ctx context.Context) context.Context {
+	return context.WithValue(ctx, snapshotCtxKey{}, &Snapshot{values: map[string]any{}})
+}
+
+// GetSnapshot returns the snapshot stored in the context by WithSnapshot,
+// or a detached empty snapshot if there is none, so values can always be set.
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the snapshot.
+//
+// Returns:
+//
+//	*Snapshot: The snapshot of the operation.
+func GetSnapshot(ctx context.Context) *Snapshot {
+	if s, ok := ctx.Value(snapshotCtxKey{}).(*Snapshot); ok {
+		return s
+	}
+	return &Snapshot{values: map[string]any{}}
+}
+
+// Set stores the value of key, replacing the previous value.
+func (s *Snapshot) Set(key string, value any) *Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.values[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.values[key] = value
+	return s
+}
+
+// MarshalZerologObject writes the accumulated values, implementing zerolog.LogObjectMarshaler.
+func (s *Snapshot) MarshalZerologObject(e *zerolog.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range s.keys {
+		e.Interface(key, s.values[key])
+	}
+}
@@ -0,0 +1,38 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	t.Run("GetSnapshot when layers set values should attach them to the error log", func(t *testing.T) {
+		buff.Reset()
+		ctx := WithSnapshot(context.TODO())
+		charge := func(ctx context.Context) error {
+			GetSnapshot(ctx).Set("step", "charge").Set("amount", 12)
+			return errors.New("declined")
+		}
+
+		GetSnapshot(ctx).Set("step", "validate").Set("order_id", "42")
+		err := charge(ctx)
+		Err(ctx, err).Object("snapshot", GetSnapshot(ctx)).Msg("checkout failed")
+
+		assert.Contains(t, buff.String(), "\"snapshot\":{\"step\":\"charge\",\"order_id\":\"42\",\"amount\":12}")
+	})
+
+	t.Run("GetSnapshot when context does not carry a snapshot should return a detached one", func(t *testing.T) {
+		GetSnapshot(context.TODO()).Set("step", "charge")
+
+		assert.Empty(t, GetSnapshot(context.TODO()).keys)
+	})
+}
@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// readableSpan is implemented by the spans exposing their metadata, such as the OpenTelemetry SDK spans.
+type readableSpan interface {
+	Name() string
+	SpanKind() trace.SpanKind
+}
+
+// WithSpanMetadata returns an event option that writes the name and kind of the active recording span
+// as the 'span_name' and 'span_kind' fields. Log events created with a context without a readable recording span are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.WithSpanMetadata())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the span metadata.
+func WithSpanMetadata() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		span := trace.SpanFromContext(ctx)
+		if !span.IsRecording() {
+			return e
+		}
+
+		s, ok := span.(readableSpan)
+		if !ok {
+			return e
+		}
+
+		if f, ok := ctx.Value(spanFinishCtxKey{}).(*spanFinishFields); ok {
+			f.name.Store(true)
+		}
+		return e.Str("span_name", s.Name()).Str("span_kind", s.SpanKind().String())
+	}
+}
+
+// TraceFields returns an event option that writes the trace and span identifiers of the span carried by the context
+// as the 'trace_id' and 'span_id' fields, correlating log events with traces.
+// Log events created with a context without a valid span context are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.TraceFields())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the trace fields.
+func TraceFields() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		sc, ok := spanContext(ctx)
+		if !ok {
+			return e
+		}
+
+		if f, ok := ctx.Value(spanFinishCtxKey{}).(*spanFinishFields); ok {
+			f.trace.Store(true)
+		}
+		return e.Str("trace_id", sc.TraceID().String()).Str("span_id", sc.SpanID().String())
+	}
+}
+
+// spanContext returns the span context carried by the context, and whether it is valid.
+func spanContext(ctx context.Context) (trace.SpanContext, bool) {
+	sc := trace.SpanContextFromContext(ctx)
+	return sc, sc.IsValid()
+}
@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type spanFinishCtxKey struct{}
+
+// spanFinishFields records the span fields written by the event options to a span completion log event,
+// so LogSpanFinish only writes the missing ones.
+type spanFinishFields struct {
+	trace atomic.Bool
+	name  atomic.Bool
+}
+
+// LogSpanFinish logs the completion of a span created outside the logger, with the 'span_name' and 'span_duration_ms' fields
+// and the 'trace_id' and 'span_id' fields of the span carried by the context, giving a consistent completion log across services.
+// The span is logged at the "info" level, or at the "error" level with the 'error' field when the context carries an error
+// stored by WithError. Errors registered with WithExpectedErrors are logged at their configured level.
+// The fields already written by the configured TraceFields and WithSpanMetadata event options are not repeated.
+//
+// Example usage:
+//
+//	start := time.Now()
+//	ctx, span := tracer.Start(ctx, "charge")
+//	defer func() {
+//	    span.End()
+//	    logger.LogSpanFinish(ctx, start, "charge")
+//	}()
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the span and the bound error.
+//	startTime (time.Time): The time the span started.
+//	name (string): The name of the span.
+func LogSpanFinish(ctx context.Context, startTime time.Time, name string) {
+	cfg := config()
+	err, _ := ctx.Value(boundErrCtxKey{}).(error)
+
+	fields := &spanFinishFields{}
+	ctx = context.WithValue(ctx, spanFinishCtxKey{}, fields)
+
+	level := zerolog.InfoLevel
+	if err != nil {
+		level = cfg.errorLevel(err)
+	}
+
+	e := errEvent(ctx, newEvent(ctx, level), err).
+		Float64("span_duration_ms", float64(time.Since(startTime))/float64(time.Millisecond))
+
+	e = event(ctx, e)
+	if !fields.name.Load() {
+		e = e.Str("span_name", name)
+	}
+	if !fields.trace.Load() {
+		e = TraceFields()(ctx, e)
+	}
+
+	e.Msg("span finished")
+}
@@ -0,0 +1,75 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLogSpanFinish(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanCtx := trace.ContextWithSpanContext(context.TODO(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	t.Run("LogSpanFinish when span succeeds should log the span at the info level", func(t *testing.T) {
+		LogSpanFinish(spanCtx, time.Now().Add(-50*time.Millisecond), "charge")
+
+		f := fields()
+		assert.Equal(t, "info", f["level"])
+		assert.Equal(t, "span finished", f["message"])
+		assert.Equal(t, "charge", f["span_name"])
+		assert.GreaterOrEqual(t, f["span_duration_ms"], float64(50))
+		assert.Equal(t, traceID.String(), f["trace_id"])
+		assert.Equal(t, spanID.String(), f["span_id"])
+		assert.NotContains(t, f, "error")
+	})
+
+	t.Run("LogSpanFinish when context carries an error should log the span at the error level", func(t *testing.T) {
+		LogSpanFinish(WithError(spanCtx, errors.New("card declined")), time.Now(), "charge")
+
+		f := fields()
+		assert.Equal(t, "error", f["level"])
+		assert.Equal(t, "card declined", f["error"])
+		assert.Equal(t, "charge", f["span_name"])
+	})
+
+	t.Run("LogSpanFinish when event options write the span fields should not repeat them", func(t *testing.T) {
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithEventFields(TraceFields())
+			cfg.WithEventFields(WithSpanMetadata())
+		})
+		ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.TODO(), "charge")
+		defer span.End()
+
+		LogSpanFinish(ctx, time.Now(), "charge")
+
+		for _, key := range []string{"trace_id", "span_id", "span_name"} {
+			assert.Equal(t, 1, strings.Count(buff.String(), `"`+key+`":`), key)
+		}
+		assert.Equal(t, span.SpanContext().TraceID().String(), fields()["trace_id"])
+	})
+}
@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// linkedSpan is implemented by the spans exposing their links, such as the OpenTelemetry SDK spans.
+type linkedSpan interface {
+	Links() []sdktrace.Link
+}
+
+// WithSpanLinks returns an event option that writes the trace identifiers linked to the active recording span,
+// such as the upstream traces of a batch processed by a single span, as the 'linked_trace_ids' array, in the order of the links.
+// Log events created with a context without a readable recording span, or whose span has no valid links, are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.WithSpanLinks())
+//	ctx, span := tracer.Start(ctx, "process-batch", trace.WithLinks(links...))
+//	logger.Info(ctx).Msg("batch processed") // "linked_trace_ids":["4bf9...","a3ce..."]
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the linked trace identifiers.
+func WithSpanLinks() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		span := trace.SpanFromContext(ctx)
+		if !span.IsRecording() {
+			return e
+		}
+
+		s, ok := span.(linkedSpan)
+		if !ok {
+			return e
+		}
+
+		ids := []string{}
+		for _, link := range s.Links() {
+			if link.SpanContext.IsValid() {
+				ids = append(ids, link.SpanContext.TraceID().String())
+			}
+		}
+		if len(ids) == 0 {
+			return e
+		}
+
+		return e.Strs("linked_trace_ids", ids)
+	}
+}
@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestWithSpanLinks(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(WithSpanLinks())
+	})
+	tracer := sdktrace.NewTracerProvider().Tracer("test")
+
+	link := func(traceHex string) trace.Link {
+		traceID, _ := trace.TraceIDFromHex(traceHex)
+		spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+		return trace.Link{SpanContext: trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})}
+	}
+
+	t.Run("WithSpanLinks when span carries links should write the linked trace IDs", func(t *testing.T) {
+		buff.Reset()
+		ctx, span := tracer.Start(context.TODO(), "process-batch", trace.WithLinks(
+			link("4bf92f3577b34da6a3ce929d0e0e4736"),
+			link("a3ce929d0e0e47364bf92f3577b34da6"),
+		))
+		defer span.End()
+
+		Info(ctx).Msg("batch processed")
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		assert.Equal(t, []any{"4bf92f3577b34da6a3ce929d0e0e4736", "a3ce929d0e0e47364bf92f3577b34da6"}, fields["linked_trace_ids"])
+	})
+
+	t.Run("WithSpanLinks when span has no links should not write the linked trace IDs", func(t *testing.T) {
+		buff.Reset()
+		ctx, span := tracer.Start(context.TODO(), "process-batch")
+		defer span.End()
+
+		Info(ctx).Msg("batch processed")
+
+		assert.NotContains(t, buff.String(), "linked_trace_ids")
+	})
+
+	t.Run("WithSpanLinks when span is not readable should not write the linked trace IDs", func(t *testing.T) {
+		buff.Reset()
+		ctx, _ := noop.NewTracerProvider().Tracer("test").Start(context.TODO(), "process-batch",
+			trace.WithLinks(link("4bf92f3577b34da6a3ce929d0e0e4736")))
+
+		Info(ctx).Msg("batch processed")
+
+		assert.NotContains(t, buff.String(), "linked_trace_ids")
+	})
+}
@@ -0,0 +1,36 @@
+package logger
+
+import (
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithSpanStatusOnError marks the active recording span as failed when an error or fatal event is written,
+// setting the span status to codes.Error with the event message. Errors attached by Err are also recorded on the span,
+// once the event is written, so events that are never sent leave the span unchanged.
+// Log events created with a context without a recording span are not changed.
+//
+// Example usage:
+//
+//	cfg.WithSpanStatusOnError()
+//	logger.Err(ctx, err).Msg("payment failed") // The span carried by ctx is marked as failed.
+func (cfg *LoggerConfig) WithSpanStatusOnError() {
+	cfg.spanErrors = true
+
+	cfg.hooks = append(cfg.hooks, zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+		if level < zerolog.ErrorLevel || !e.Enabled() {
+			return
+		}
+
+		ctx := e.GetCtx()
+		span := trace.SpanFromContext(ctx)
+		if !span.IsRecording() {
+			return
+		}
+		if err, ok := ctx.Value(errCtxKey{}).(error); ok {
+			span.RecordError(err)
+		}
+		span.SetStatus(codes.Error, msg)
+	}))
+}
@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithSpanStatusOnError(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithSpanStatusOnError()
+	})
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)).Tracer("test")
+
+	t.Run("Err when context carries a recording span should mark the span as failed", func(t *testing.T) {
+		exporter.Reset()
+		ctx, span := tracer.Start(context.TODO(), "operation")
+
+		Err(ctx, errors.New("some error")).Msg("operation failed")
+		span.End()
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Equal(t, "operation failed", spans[0].Status.Description)
+		assert.Len(t, spans[0].Events, 1)
+		assert.Equal(t, "exception", spans[0].Events[0].Name)
+	})
+
+	t.Run("Err when the event is not sent should not change the span", func(t *testing.T) {
+		exporter.Reset()
+		ctx, span := tracer.Start(context.TODO(), "operation")
+
+		_ = Err(ctx, errors.New("some error"))
+		span.End()
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, codes.Unset, spans[0].Status.Code)
+		assert.Empty(t, spans[0].Events)
+	})
+
+	t.Run("Info when context carries a recording span should not change the span status", func(t *testing.T) {
+		exporter.Reset()
+		ctx, span := tracer.Start(context.TODO(), "operation")
+
+		Info(ctx).Msg("operation succeeded")
+		span.End()
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	})
+
+	t.Run("Error when context does not carry a span should write the event", func(t *testing.T) {
+		buff.Reset()
+
+		Error(context.TODO()).Msg("no span")
+
+		assert.Contains(t, buff.String(), "\"message\":\"no span\"")
+	})
+}
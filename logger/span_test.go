@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestWithSpanMetadata(t *testing.T) {
+	tracer := sdktrace.NewTracerProvider().Tracer("test")
+
+	suts := map[string]struct {
+		ctx    func() context.Context
+		assert func(t *testing.T, msg string)
+	}{
+		"WithSpanMetadata when context carries a recording span should write the span name and kind": {
+			ctx: func() context.Context {
+				ctx, _ := tracer.Start(context.TODO(), "charge", trace.WithSpanKind(trace.SpanKindServer))
+				return ctx
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"span_name\":\"charge\"")
+				assert.Contains(t, msg, "\"span_kind\":\"server\"")
+			},
+		},
+		"WithSpanMetadata when span is not recording should not write span fields": {
+			ctx: func() context.Context {
+				ctx, _ := noop.NewTracerProvider().Tracer("test").Start(context.TODO(), "charge")
+				return ctx
+			},
+			assert: func(t *testing.T, msg string) {
+				assert.NotContains(t, msg, "span_name")
+			},
+		},
+		"WithSpanMetadata when context does not carry a span should not write span fields": {
+			ctx: context.TODO,
+			assert: func(t *testing.T, msg string) {
+				assert.NotContains(t, msg, "span_name")
+				assert.NotContains(t, msg, "span_kind")
+			},
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithEventFields(WithSpanMetadata())
+			})
+
+			Info(sut.ctx()).Msg("span log")
+
+			sut.assert(t, buff.String())
+		})
+	}
+}
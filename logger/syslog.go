@@ -0,0 +1,172 @@
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	syslogFacilityUser = 1
+	syslogMinBackoff   = 100 * time.Millisecond
+	syslogMaxBackoff   = 30 * time.Second
+	syslogTimeFormat   = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+var syslogLocalAddrs = []string{"/dev/log", "/var/run/syslog", "/var/run/log"}
+
+// WithSyslog sets a syslog server as the output destination, replacing the writer set by WithWriter.
+// Log events are sent as RFC 5424 messages, with the rendered event as the MSG part and the event level mapped to the syslog severity.
+// An empty network dials the local syslog socket. When the connection is lost, log events are dropped, and counted by Dropped,
+// until it is reestablished, retrying with an exponential backoff.
+//
+// Example usage:
+//
+//	cfg.WithSyslog("udp", "syslog.internal:514", "payment-service")
+//	cfg.WithSyslog("", "", "payment-service") // Local syslog socket.
+//
+// Params:
+//
+//	network (string): The network of the syslog server, such as "tcp" or "udp", or empty for the local socket.
+//	addr (string): The address of the syslog server.
+//	tag (string): The APP-NAME of the messages, or empty to send the "-" NILVALUE.
+func (cfg *LoggerConfig) WithSyslog(network, addr, tag string) {
+	w := newSyslogWriter(network, addr, tag)
+	cfg.w = w
+	cfg.closers = append(cfg.closers, w)
+}
+
+type syslogWriter struct {
+	network  string
+	addr     string
+	tag      string
+	hostname string
+	mu       sync.Mutex
+	conn     net.Conn
+	backoff  time.Duration
+	retryAt  time.Time
+}
+
+func newSyslogWriter(network, addr, tag string) *syslogWriter {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+	if tag == "" {
+		tag = "-"
+	}
+	return &syslogWriter{network: network, addr: addr, tag: tag, hostname: hostname}
+}
+
+func (w *syslogWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *syslogWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.conn == nil {
+		if err := w.connect(); err != nil {
+			dropRendered(DropWriteFailed, level, p)
+			return 0, err
+		}
+	}
+
+	if _, err := w.conn.Write(w.format(level, p)); err != nil {
+		w.disconnect()
+		dropRendered(DropWriteFailed, level, p)
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (w *syslogWriter) connect() error {
+	if time.Now().Before(w.retryAt) {
+		return fmt.Errorf("syslog: reconnecting in %s", time.Until(w.retryAt))
+	}
+
+	conn, err := w.dial()
+	if err != nil {
+		w.backoff = min(max(2*w.backoff, syslogMinBackoff), syslogMaxBackoff)
+		w.retryAt = time.Now().Add(w.backoff)
+		return err
+	}
+
+	w.conn, w.backoff = conn, 0
+	return nil
+}
+
+func (w *syslogWriter) dial() (net.Conn, error) {
+	if w.network != "" {
+		return net.DialTimeout(w.network, w.addr, time.Second)
+	}
+
+	for _, network := range []string{"unixgram", "unix"} {
+		for _, addr := range syslogLocalAddrs {
+			if conn, err := net.DialTimeout(network, addr, time.Second); err == nil {
+				return conn, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("syslog: local socket not found")
+}
+
+func (w *syslogWriter) disconnect() {
+	_ = w.conn.Close()
+	w.conn = nil
+	w.backoff = syslogMinBackoff
+	w.retryAt = time.Now().Add(w.backoff)
+}
+
+// format renders p as an RFC 5424 message, framed by octet counting on stream connections.
+func (w *syslogWriter) format(level zerolog.Level, p []byte) []byte {
+	pri := syslogFacilityUser*8 + syslogSeverity(level)
+	msg := fmt.Sprintf("<%d>1 %s %s %s %d - - %s",
+		pri, time.Now().Format(syslogTimeFormat), w.hostname, w.tag, os.Getpid(), bytes.TrimSuffix(p, []byte("\n")))
+
+	if network := w.conn.RemoteAddr().Network(); network == "tcp" || network == "unix" {
+		return []byte(strconv.Itoa(len(msg)) + " " + msg)
+	}
+	return []byte(msg)
+}
+
+// Close closes the connection with the syslog server.
+func (w *syslogWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.conn == nil {
+		return nil
+	}
+
+	err := w.conn.Close()
+	w.conn = nil
+	return err
+}
+
+func syslogSeverity(level zerolog.Level) int {
+	switch level {
+	case zerolog.PanicLevel:
+		return 0 // Emergency
+	case zerolog.FatalLevel:
+		return 2 // Critical
+	case zerolog.ErrorLevel:
+		return 3 // Error
+	case zerolog.WarnLevel:
+		return 4 // Warning
+	case zerolog.InfoLevel:
+		return 6 // Informational
+	case zerolog.DebugLevel, zerolog.TraceLevel:
+		return 7 // Debug
+	default:
+		return 5 // Notice
+	}
+}
@@ -0,0 +1,128 @@
+package logger
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSyslog(t *testing.T) {
+	t.Run("WithSyslog when using udp should send RFC 5424 messages with the level severity", func(t *testing.T) {
+		server, err := net.ListenPacket("udp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		defer server.Close()
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithSyslog("udp", server.LocalAddr().String(), "payment-service")
+		})
+		defer Shutdown(context.TODO())
+
+		Error(context.TODO()).Msg("syslog message")
+
+		buf := make([]byte, 1024)
+		n, _, err := server.ReadFrom(buf)
+		assert.NoError(t, err)
+		msg := string(buf[:n])
+		assert.True(t, strings.HasPrefix(msg, "<11>1 "))
+		assert.Contains(t, msg, " payment-service ")
+		assert.Contains(t, msg, " - - {\"level\":\"error\"")
+		assert.Contains(t, msg, "\"message\":\"syslog message\"}")
+	})
+
+	t.Run("WithSyslog when tag is empty should send the NILVALUE as the APP-NAME", func(t *testing.T) {
+		server, err := net.ListenPacket("udp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		defer server.Close()
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithSyslog("udp", server.LocalAddr().String(), "")
+		})
+		defer Shutdown(context.TODO())
+
+		Error(context.TODO()).Msg("syslog message")
+
+		buf := make([]byte, 1024)
+		n, _, err := server.ReadFrom(buf)
+		assert.NoError(t, err)
+		header := strings.Fields(string(buf[:n]))
+		assert.Equal(t, "-", header[3])
+	})
+
+	t.Run("WithSyslog when format is console should keep the level severity", func(t *testing.T) {
+		server, err := net.ListenPacket("udp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		defer server.Close()
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithSyslog("udp", server.LocalAddr().String(), "payment-service")
+			cfg.WithFormat(FormatConsole)
+		})
+		defer Shutdown(context.TODO())
+
+		Error(context.TODO()).Msg("syslog message")
+
+		buf := make([]byte, 1024)
+		n, _, err := server.ReadFrom(buf)
+		assert.NoError(t, err)
+		msg := string(buf[:n])
+		assert.True(t, strings.HasPrefix(msg, "<11>1 "), msg)
+		assert.Contains(t, msg, "syslog message")
+	})
+
+	t.Run("WithSyslog when using tcp should frame messages by octet counting", func(t *testing.T) {
+		server, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		defer server.Close()
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithSyslog("tcp", server.Addr().String(), "payment-service")
+		})
+		defer Shutdown(context.TODO())
+
+		Warn(context.TODO()).Msg("syslog message")
+
+		conn, err := server.Accept()
+		assert.NoError(t, err)
+		defer conn.Close()
+		length, err := bufio.NewReader(conn).ReadString(' ')
+		assert.NoError(t, err)
+		assert.NotEmpty(t, strings.TrimSpace(length))
+	})
+
+	t.Run("WithSyslog when server is unreachable should drop and count the events", func(t *testing.T) {
+		server, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		addr := server.Addr().String()
+		server.Close()
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithSyslog("tcp", addr, "payment-service")
+		})
+		defer Shutdown(context.TODO())
+		before := Dropped()
+
+		Info(context.TODO()).Msg("first")
+		Info(context.TODO()).Msg("second")
+
+		assert.Equal(t, uint64(2), Dropped()-before)
+	})
+}
+
+func TestSyslogSeverity(t *testing.T) {
+	severities := map[zerolog.Level]int{
+		zerolog.TraceLevel: 7,
+		zerolog.DebugLevel: 7,
+		zerolog.InfoLevel:  6,
+		zerolog.WarnLevel:  4,
+		zerolog.ErrorLevel: 3,
+		zerolog.FatalLevel: 2,
+		zerolog.PanicLevel: 0,
+		zerolog.NoLevel:    5,
+	}
+
+	for level, severity := range severities {
+		t.Run("syslogSeverity when level is "+level.String()+" should map the severity", func(t *testing.T) {
+			assert.Equal(t, severity, syslogSeverity(level))
+		})
+	}
+}
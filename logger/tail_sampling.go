@@ -0,0 +1,142 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// WithTailSampling samples bursts of identical log events, sharing the same level and message, within a window.
+// The first head events of a burst are written immediately, while only the last tail events are written when the window closes,
+// followed by a summary event with the 'dropped_middle' field counting the discarded events in between.
+// Fatal and panic events are never sampled.
+//
+// Example usage:
+//
+//	cfg.WithTailSampling(5, 5, time.Minute) // Writes the first and last 5 identical events of each minute.
+//
+// Params:
+//
+//	head (int): The number of events written at the beginning of a burst.
+//	tail (int): The number of events written at the end of a burst.
+//	window (time.Duration): The duration of a burst, starting at its first event.
+func (cfg *LoggerConfig) WithTailSampling(head, tail int, window time.Duration) {
+	cfg.writerOptions = append(cfg.writerOptions, func(w zerolog.LevelWriter) zerolog.LevelWriter {
+		return &tailSamplingWriter{
+			w:      w,
+			head:   head,
+			tail:   tail,
+			window: window,
+			bursts: map[burstKey]*burst{},
+		}
+	})
+}
+
+type burstKey struct {
+	level zerolog.Level
+	msg   string
+}
+
+type burst struct {
+	count   int
+	dropped int
+	tail    [][]byte
+	timer   *time.Timer
+}
+
+type tailSamplingWriter struct {
+	w      zerolog.LevelWriter
+	head   int
+	tail   int
+	window time.Duration
+	mu     sync.Mutex
+	bursts map[burstKey]*burst
+}
+
+func (w *tailSamplingWriter) Write(p []byte) (int, error) {
+	return w.w.Write(p)
+}
+
+func (w *tailSamplingWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	if level >= zerolog.FatalLevel {
+		return w.w.WriteLevel(level, p)
+	}
+
+	key := burstKey{level: level, msg: message(p)}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	b, ok := w.bursts[key]
+	if !ok {
+		b = &burst{}
+		b.timer = time.AfterFunc(w.window, func() { w.close(key) })
+		w.bursts[key] = b
+	}
+
+	b.count++
+	if b.count <= w.head {
+		return w.w.WriteLevel(level, p)
+	}
+
+	b.tail = append(b.tail, bytes.Clone(p))
+	if len(b.tail) > w.tail {
+		b.tail = b.tail[1:]
+		b.dropped++
+		drop(DropSampled, level, key.msg)
+	}
+
+	return len(p), nil
+}
+
+// Sync closes every open window, writing the sampled events, and flushes the underlying writer.
+func (w *tailSamplingWriter) Sync() error {
+	w.mu.Lock()
+	for key, b := range w.bursts {
+		b.timer.Stop()
+		w.flushBurst(key, b)
+	}
+	w.mu.Unlock()
+
+	return syncWriter(w.w)
+}
+
+func (w *tailSamplingWriter) close(key burstKey) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if b, ok := w.bursts[key]; ok {
+		w.flushBurst(key, b)
+	}
+}
+
+func (w *tailSamplingWriter) flushBurst(key burstKey, b *burst) {
+	delete(w.bursts, key)
+
+	for _, p := range b.tail {
+		_, _ = w.w.WriteLevel(key.level, p)
+	}
+
+	if b.dropped > 0 {
+		summary := &bytes.Buffer{}
+		l := zerolog.New(summary)
+		l.WithLevel(key.level).Timestamp().Int("dropped_middle", b.dropped).Msg(key.msg)
+		_, _ = w.w.WriteLevel(key.level, summary.Bytes())
+	}
+}
+
+// message returns the message of a JSON rendered log event.
+func message(p []byte) string {
+	fields, err := decodeFields(p)
+	if err != nil {
+		return ""
+	}
+	defer releaseFields(fields)
+
+	var msg string
+	_ = json.Unmarshal(fields[zerolog.MessageFieldName], &msg)
+	return msg
+}
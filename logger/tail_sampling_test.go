@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type lockedBuffer struct {
+	mu sync.Mutex
+	b  bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.Write(p)
+}
+
+func (b *lockedBuffer) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.b.Reset()
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.String()
+}
+
+func TestWithTailSampling(t *testing.T) {
+	buff := &lockedBuffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithTailSampling(3, 2, 50*time.Millisecond)
+	})
+	before := Dropped()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Error(context.TODO()).Msg("burst")
+		}()
+	}
+	wg.Wait()
+	Info(context.TODO()).Msg("other")
+
+	t.Run("WithTailSampling when window is open should write only the head events", func(t *testing.T) {
+		assert.Equal(t, 3, strings.Count(buff.String(), "\"message\":\"burst\""))
+		assert.Equal(t, 1, strings.Count(buff.String(), "\"message\":\"other\""))
+	})
+
+	t.Run("WithTailSampling when window closes should write the tail events and the summary", func(t *testing.T) {
+		assert.Eventually(t, func() bool {
+			return strings.Contains(buff.String(), "\"dropped_middle\":45")
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, 6, strings.Count(buff.String(), "\"message\":\"burst\""))
+		assert.Equal(t, uint64(45), Dropped()-before)
+	})
+}
@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+)
+
+var missingPlaceholders atomic.Uint64
+
+var placeholder = regexp.MustCompile(`\{([A-Za-z0-9_.-]+)\}`)
+
+// Tmpl starts a new logging event at the "info" level whose message is rendered from a template with named placeholders,
+// such as "user {user_id} did {action}", while every argument is also written as a field.
+// Placeholders without an argument are left literal in the message and counted by MissingPlaceholders.
+// The message is already set, so it returns a *zerolog.Event that is not sent until the Send method is called.
+//
+// Example usage:
+//
+//	logger.Tmpl(ctx, "user {user_id} did {action}", map[string]any{"user_id": 42, "action": "login"}).Send()
+//	// "user_id":42,"action":"login","message":"user 42 did login"
+//
+// Params:
+//
+//	ctx (context.Context): The context from which to extract tracing information.
+//	template (string): The message template.
+//	args (map[string]any): The values of the placeholders, by name.
+//
+// Returns:
+//
+//	*zerolog.Event: A pointer to the log event. Ensure to call Send to emit the log.
+func Tmpl(ctx context.Context, template string, args map[string]any) *zerolog.Event {
+	e := event(ctx, newEvent(ctx, zerolog.InfoLevel))
+	if !e.Enabled() {
+		return e
+	}
+
+	msg := placeholder.ReplaceAllStringFunc(template, func(match string) string {
+		value, ok := args[match[1:len(match)-1]]
+		if !ok {
+			missingPlaceholders.Add(1)
+			return match
+		}
+		return fmt.Sprint(value)
+	})
+
+	return e.Fields(args).Str(zerolog.MessageFieldName, msg)
+}
+
+// MissingPlaceholders returns the number of template placeholders rendered by Tmpl without an argument
+// since the program started or the last Reset.
+//
+// Returns:
+//
+//	uint64: The number of missing placeholders.
+func MissingPlaceholders() uint64 {
+	return missingPlaceholders.Load()
+}
@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTmpl(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	t.Run("Tmpl when placeholders have arguments should render the message and promote the fields", func(t *testing.T) {
+		buff.Reset()
+
+		Tmpl(context.TODO(), "user {user_id} did {action}", map[string]any{"user_id": 42, "action": "login"}).Send()
+
+		msg := buff.String()
+		assert.Contains(t, msg, "\"message\":\"user 42 did login\"")
+		assert.Contains(t, msg, "\"user_id\":42")
+		assert.Contains(t, msg, "\"action\":\"login\"")
+	})
+
+	t.Run("Tmpl when placeholder is missing should leave it literal and count it", func(t *testing.T) {
+		buff.Reset()
+		before := MissingPlaceholders()
+
+		Tmpl(context.TODO(), "user {user_id} did {action}", map[string]any{"user_id": 42}).Send()
+
+		assert.Contains(t, buff.String(), "\"message\":\"user 42 did {action}\"")
+		assert.Equal(t, before+1, MissingPlaceholders())
+	})
+}
@@ -0,0 +1,132 @@
+package logger
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
+)
+
+// maxThrottleKeys is the number of rate limiters held by WithThrottle before the idle ones are evicted.
+const maxThrottleKeys = 10000
+
+// ThrottleKeyFunc represents a function that computes the key used to throttle a log event.
+// Events sharing the same key share the same rate limiter.
+type ThrottleKeyFunc func(e *zerolog.Event) string
+
+type throttleEventCtxKey struct{}
+
+type throttleEvent struct {
+	level zerolog.Level
+	msg   string
+}
+
+// ThrottleMessage returns the message of a log event being throttled, allowing a ThrottleKeyFunc to throttle events by message.
+//
+// Params:
+//
+//	e (*zerolog.Event): The event passed to the ThrottleKeyFunc.
+//
+// Returns:
+//
+//	string: The message of the event, or an empty string outside of a ThrottleKeyFunc.
+func ThrottleMessage(e *zerolog.Event) string {
+	te, _ := e.GetCtx().Value(throttleEventCtxKey{}).(throttleEvent)
+	return te.msg
+}
+
+// ThrottleLevel returns the level of a log event being throttled, allowing a ThrottleKeyFunc to throttle events by level.
+//
+// Params:
+//
+//	e (*zerolog.Event): The event passed to the ThrottleKeyFunc.
+//
+// Returns:
+//
+//	zerolog.Level: The level of the event, or zerolog.NoLevel outside of a ThrottleKeyFunc.
+func ThrottleLevel(e *zerolog.Event) zerolog.Level {
+	te, ok := e.GetCtx().Value(throttleEventCtxKey{}).(throttleEvent)
+	if !ok {
+		return zerolog.NoLevel
+	}
+	return te.level
+}
+
+// WithThrottle limits the rate of log events sharing the same key, discarding the events exceeding the limit.
+// The key is computed by keyFn right before the event is written, allowing events to be throttled by message
+// (ThrottleMessage), level (ThrottleLevel), or values extracted from the event context (e.GetCtx()).
+// Events with an empty key share one limiter. Fatal and panic events are never throttled, so the program still exits.
+// Discarded events are counted by Dropped. Idle limiters are evicted once too many keys are held.
+//
+// Example usage:
+//
+//	cfg.WithThrottle(func(e *zerolog.Event) string {
+//	    return logger.ThrottleMessage(e)
+//	}, rate.Limit(10), 10) // Writes at most 10 events per second with the same message.
+//
+// Params:
+//
+//	keyFn (ThrottleKeyFunc): The function that computes the throttling key of each event.
+//	limit (rate.Limit): The number of events per second allowed for each key.
+//	burst (int): The maximum number of events allowed at once for each key.
+func (cfg *LoggerConfig) WithThrottle(keyFn ThrottleKeyFunc, limit rate.Limit, burst int) {
+	cfg.hooks = append(cfg.hooks, &throttleHook{
+		keyFn:    keyFn,
+		limit:    limit,
+		burst:    burst,
+		limiters: map[string]*rate.Limiter{},
+	})
+}
+
+type throttleHook struct {
+	keyFn    ThrottleKeyFunc
+	limit    rate.Limit
+	burst    int
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func (h *throttleHook) Run(e *zerolog.Event, level zerolog.Level, msg string) {
+	if level >= zerolog.FatalLevel {
+		return
+	}
+
+	ctx := e.GetCtx()
+	e.Ctx(context.WithValue(ctx, throttleEventCtxKey{}, throttleEvent{level: level, msg: msg}))
+	key := h.keyFn(e)
+	e.Ctx(ctx)
+
+	if !h.limiter(key).Allow() {
+		e.Discard()
+		drop(DropThrottled, level, msg)
+	}
+}
+
+func (h *throttleHook) limiter(key string) *rate.Limiter {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	l, ok := h.limiters[key]
+	if !ok {
+		if len(h.limiters) >= maxThrottleKeys {
+			h.evict()
+		}
+		l = rate.NewLimiter(h.limit, h.burst)
+		h.limiters[key] = l
+	}
+	return l
+}
+
+// evict removes the limiters holding a full burst, which behave as new ones, and every limiter when none is idle,
+// so the number of keys held stays bounded.
+func (h *throttleHook) evict() {
+	for key, l := range h.limiters {
+		if l.Tokens() >= float64(h.burst) {
+			delete(h.limiters, key)
+		}
+	}
+	if len(h.limiters) >= maxThrottleKeys {
+		clear(h.limiters)
+	}
+}
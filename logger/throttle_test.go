@@ -0,0 +1,110 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestWithThrottle(t *testing.T) {
+	byMessage := func(e *zerolog.Event) string {
+		return ThrottleMessage(e)
+	}
+	unkeyed := func(e *zerolog.Event) string {
+		return ""
+	}
+
+	suts := map[string]struct {
+		keyFn   ThrottleKeyFunc
+		act     func(ctx context.Context)
+		lines   map[string]int
+		dropped uint64
+	}{
+		"WithThrottle when events exceed the limit should drop the exceeding events": {
+			keyFn: byMessage,
+			act: func(ctx context.Context) {
+				for i := 0; i < 5; i++ {
+					Info(ctx).Msg("throttled")
+				}
+			},
+			lines:   map[string]int{"throttled": 2},
+			dropped: 3,
+		},
+		"WithThrottle when keys differ should limit each key independently": {
+			keyFn: byMessage,
+			act: func(ctx context.Context) {
+				for i := 0; i < 5; i++ {
+					Info(ctx).Msg("first")
+					Info(ctx).Msg("second")
+				}
+			},
+			lines:   map[string]int{"first": 2, "second": 2},
+			dropped: 6,
+		},
+		"WithThrottle when keys are empty should share one limiter": {
+			keyFn: unkeyed,
+			act: func(ctx context.Context) {
+				for i := 0; i < 5; i++ {
+					Info(ctx).Msg("first")
+					Info(ctx).Msg("second")
+				}
+			},
+			lines:   map[string]int{"first": 1, "second": 1},
+			dropped: 8,
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff := &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithWriter(buff)
+				cfg.WithThrottle(sut.keyFn, 0, 2)
+			})
+			before := Dropped()
+
+			sut.act(context.TODO())
+
+			for msg, count := range sut.lines {
+				assert.Equal(t, count, strings.Count(buff.String(), "\"message\":\""+msg+"\""))
+			}
+			assert.Equal(t, sut.dropped, Dropped()-before)
+		})
+	}
+}
+
+func TestWithThrottleFatal(t *testing.T) {
+	t.Run("WithThrottle when a fatal event exceeds the limit should write it and exit", func(t *testing.T) {
+		buff := &bytes.Buffer{}
+		exited := []int{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithWriter(buff)
+			cfg.WithThrottle(func(e *zerolog.Event) string { return "" }, 0, 1)
+			cfg.WithExitFunc(func(code int) { exited = append(exited, code) })
+		})
+
+		Info(context.TODO()).Msg("first")
+		FatalCode(context.TODO(), 3).Msg("fatal")
+
+		assert.Contains(t, buff.String(), "\"message\":\"fatal\"")
+		assert.Equal(t, []int{3}, exited)
+	})
+}
+
+func TestThrottleHookEviction(t *testing.T) {
+	t.Run("throttleHook when too many keys are held should evict the idle limiters", func(t *testing.T) {
+		h := &throttleHook{limit: rate.Inf, burst: 1, limiters: map[string]*rate.Limiter{}}
+
+		for i := 0; i < maxThrottleKeys+10; i++ {
+			h.limiter(strconv.Itoa(i))
+		}
+
+		assert.LessOrEqual(t, len(h.limiters), maxThrottleKeys)
+	})
+}
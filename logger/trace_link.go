@@ -0,0 +1,36 @@
+package logger
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// WithTraceLinkTemplate writes the 'trace_url' field, rendered from the template with the identifiers of the span
+// carried by the context, so log viewers can link each log event back to its trace.
+// Only the {trace_id} and {span_id} placeholders are replaced, any other placeholder is left literal.
+// Log events created with a context without a valid span context are not changed.
+//
+// Example usage:
+//
+//	cfg.WithTraceLinkTemplate("https://apm.example.com/trace/{trace_id}?span={span_id}")
+//
+// Params:
+//
+//	template (string): The template of the trace URL.
+func (cfg *LoggerConfig) WithTraceLinkTemplate(template string) {
+	cfg.WithEventFields(func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		sc, ok := spanContext(ctx)
+		if !ok {
+			return e
+		}
+
+		url := strings.NewReplacer(
+			"{trace_id}", sc.TraceID().String(),
+			"{span_id}", sc.SpanID().String(),
+		).Replace(template)
+
+		return e.Str("trace_url", url)
+	})
+}
@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWithTraceLinkTemplate(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithTraceLinkTemplate("https://apm/trace/{trace_id}?span={span_id}&env={env}")
+	})
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanCtx := trace.ContextWithSpanContext(context.TODO(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+
+	t.Run("WithTraceLinkTemplate when context carries a span should write the trace URL", func(t *testing.T) {
+		buff.Reset()
+
+		Info(spanCtx).Msg("linked")
+
+		assert.Contains(t, buff.String(), "\"trace_url\":\"https://apm/trace/4bf92f3577b34da6a3ce929d0e0e4736?span=00f067aa0ba902b7&env={env}\"")
+	})
+
+	t.Run("WithTraceLinkTemplate when context does not carry a span should not write the trace URL", func(t *testing.T) {
+		buff.Reset()
+
+		Info(context.TODO()).Msg("linked")
+
+		assert.NotContains(t, buff.String(), "trace_url")
+	})
+}
@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CaptureTraceFields snapshots the trace and correlation details of the context into a map, so they can be stored
+// alongside a message sent to a background queue and restored by RestoreTraceFields when the message is processed.
+// The span context is captured as the 'traceparent' entry, in the W3C format, and the chain returned by CorrelationChain
+// as the comma-separated 'correlation_chain' entry. Details missing from the context are omitted.
+//
+// Example usage:
+//
+//	msg.Headers = logger.CaptureTraceFields(ctx) // {"traceparent": "00-4bf9...-00f0...-01", "correlation_chain": "edge-1,r-42"}
+//	queue.Publish(msg)
+//
+// Params:
+//
+//	ctx (context.Context): The context carrying the span context and the correlation chain.
+//
+// Returns:
+//
+//	map[string]string: The captured trace and correlation details.
+func CaptureTraceFields(ctx context.Context) map[string]string {
+	m := map[string]string{}
+
+	if sc, ok := spanContext(ctx); ok {
+		m["traceparent"] = "00-" + sc.TraceID().String() + "-" + sc.SpanID().String() + "-" + sc.TraceFlags().String()
+	}
+	if chain := CorrelationChain(ctx); len(chain) > 0 {
+		m["correlation_chain"] = strings.Join(chain, ",")
+	}
+
+	return m
+}
+
+// RestoreTraceFields stores the trace and correlation details captured by CaptureTraceFields into the context,
+// so the log events of the worker processing a queued message carry the IDs of the request that enqueued it.
+// The span context is stored as a remote span context, written by TraceFields, unless the context already carries a valid one.
+// The correlation chain is stored as the upstream IDs, written by CorrelationChainField. Malformed entries are ignored.
+//
+// Example usage:
+//
+//	ctx := logger.RestoreTraceFields(context.Background(), msg.Headers)
+//	logger.Info(ctx).Msg("processing message") // Includes the 'trace_id' and 'span_id' fields of the enqueuing request.
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the details are stored.
+//	m (map[string]string): The details captured by CaptureTraceFields.
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the trace and correlation details.
+func RestoreTraceFields(ctx context.Context, m map[string]string) context.Context {
+	if _, ok := spanContext(ctx); !ok {
+		if sc, ok := parseTraceparent(m["traceparent"]); ok {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
+		}
+	}
+
+	if chain := m["correlation_chain"]; chain != "" {
+		for _, id := range strings.Split(chain, ",") {
+			ctx = WithUpstream(ctx, truncate(strings.TrimSpace(id), maxHeaderTagLength))
+		}
+	}
+
+	return ctx
+}
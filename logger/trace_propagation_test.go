@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRestoreTraceFields(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(TraceFields())
+		cfg.WithEventFields(CorrelationChainField())
+	})
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+
+	t.Run("RestoreTraceFields when fields round-trip through a queue should write the original IDs", func(t *testing.T) {
+		buff.Reset()
+		ctx := trace.ContextWithSpanContext(context.TODO(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+		ctx = WithRequestID(WithUpstream(ctx, "edge-1"), "r-42")
+
+		queue := make(chan []byte, 1)
+		headers, _ := json.Marshal(CaptureTraceFields(ctx))
+		queue <- headers
+
+		var m map[string]string
+		assert.NoError(t, json.Unmarshal(<-queue, &m))
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", m["traceparent"])
+
+		Info(RestoreTraceFields(context.Background(), m)).Msg("processing message")
+
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		assert.Equal(t, traceID.String(), fields["trace_id"])
+		assert.Equal(t, spanID.String(), fields["span_id"])
+		assert.Equal(t, []any{"edge-1", "r-42"}, fields["correlation_chain"])
+	})
+
+	t.Run("CaptureTraceFields when context carries no details should return an empty map", func(t *testing.T) {
+		assert.Empty(t, CaptureTraceFields(context.TODO()))
+	})
+
+	t.Run("RestoreTraceFields when entries are malformed should ignore them", func(t *testing.T) {
+		ctx := RestoreTraceFields(context.TODO(), map[string]string{"traceparent": "00-invalid"})
+
+		_, ok := spanContext(ctx)
+		assert.False(t, ok)
+		assert.Empty(t, CorrelationChain(ctx))
+	})
+}
@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceSampledFieldName is the internal field carrying the sampled flag of the event span to the trace routed writer,
+// removed before the record options and the writers see the log event.
+const traceSampledFieldName = "_trace_sampled"
+
+var (
+	traceSampledTrue  = []byte(`,"` + traceSampledFieldName + `":true`)
+	traceSampledFalse = []byte(`,"` + traceSampledFieldName + `":false`)
+)
+
+type traceWriters struct {
+	sampled   io.Writer
+	unsampled io.Writer
+}
+
+// WithTraceRoutedWriters routes the log events created with a context carrying a sampled span to the sampled writer,
+// such as a sink with longer retention, and every other log event to the unsampled writer, including the events without a span.
+// The trace routed writers replace the writer set by WithWriter, but not the ones set by WithWriterForLevelRange,
+// which take precedence.
+//
+// Example usage:
+//
+//	cfg.WithTraceRoutedWriters(retainedSink, cheapSink)
+//
+// Params:
+//
+//	sampled (io.Writer): The output destination for the log events of sampled traces.
+//	unsampled (io.Writer): The output destination for every other log event.
+func (cfg *LoggerConfig) WithTraceRoutedWriters(sampled, unsampled io.Writer) {
+	cfg.traceWriters = &traceWriters{sampled: sampled, unsampled: unsampled}
+}
+
+// traceRouting reports whether the log events are routed by the sampled flag of their span.
+func (cfg *LoggerConfig) traceRouting() bool {
+	return cfg.traceWriters != nil && len(cfg.levelWriters) == 0
+}
+
+// traceSampledHook writes the sampled flag of the event span, read by the trace routed writer.
+var traceSampledHook = zerolog.HookFunc(func(e *zerolog.Event, level zerolog.Level, msg string) {
+	sampled := trace.SpanFromContext(e.GetCtx()).SpanContext().IsSampled()
+	e.Bool(traceSampledFieldName, sampled)
+})
+
+// traceRoutedWriter dispatches each log event by the sampled flag written by the traceSampledHook, removing it.
+// It sits between the writer options and the record options, each route having its own record options and writers.
+type traceRoutedWriter struct {
+	sampled   zerolog.LevelWriter
+	unsampled zerolog.LevelWriter
+}
+
+func (w *traceRoutedWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *traceRoutedWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	n := len(p)
+
+	// The hook appends the flag after the other fields, so the last occurrence is the one it wrote.
+	out, flag := w.unsampled, traceSampledFalse
+	i := bytes.LastIndex(p, traceSampledFalse)
+	if j := bytes.LastIndex(p, traceSampledTrue); j > i {
+		out, flag, i = w.sampled, traceSampledTrue, j
+	}
+	if i >= 0 {
+		p = append(p[:i:i], p[i+len(flag):]...)
+	}
+
+	if _, err := out.WriteLevel(level, p); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Sync flushes both writers when supported.
+func (w *traceRoutedWriter) Sync() error {
+	return errors.Join(syncWriter(w.sampled), syncWriter(w.unsampled))
+}
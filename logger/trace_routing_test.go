@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWithTraceRoutedWriters(t *testing.T) {
+	spanCtx := func(flags trace.TraceFlags) context.Context {
+		return trace.ContextWithSpanContext(context.TODO(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{1},
+			TraceFlags: flags,
+		}))
+	}
+
+	suts := map[string]struct {
+		ctx     context.Context
+		sampled bool
+	}{
+		"WithTraceRoutedWriters when span is sampled should write to the sampled writer":       {ctx: spanCtx(trace.FlagsSampled), sampled: true},
+		"WithTraceRoutedWriters when span is not sampled should write to the unsampled writer": {ctx: spanCtx(0), sampled: false},
+		"WithTraceRoutedWriters when there is no span should write to the unsampled writer":    {ctx: context.TODO(), sampled: false},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			sampled, unsampled := &bytes.Buffer{}, &bytes.Buffer{}
+			Configure(func(cfg *LoggerConfig) {
+				cfg.WithTraceRoutedWriters(sampled, unsampled)
+			})
+
+			Info(sut.ctx).Msg("routed")
+
+			written, skipped := unsampled, sampled
+			if sut.sampled {
+				written, skipped = sampled, unsampled
+			}
+			assert.Contains(t, written.String(), "\"message\":\"routed\"")
+			assert.NotContains(t, written.String(), traceSampledFieldName)
+			assert.Empty(t, skipped.String())
+		})
+	}
+}
+
+func TestTraceRoutingMarker(t *testing.T) {
+	sampledCtx := trace.ContextWithSpanContext(context.TODO(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	t.Run("WithTraceRoutedWriters when level range writers are set should not write the sampled flag", func(t *testing.T) {
+		sampled, unsampled, ranged := &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithTraceRoutedWriters(sampled, unsampled)
+			cfg.WithWriterForLevelRange(zerolog.TraceLevel, zerolog.PanicLevel, ranged)
+		})
+
+		Info(sampledCtx).Msg("ranged")
+
+		assert.Contains(t, ranged.String(), "\"message\":\"ranged\"")
+		assert.NotContains(t, ranged.String(), traceSampledFieldName)
+	})
+
+	t.Run("WithTraceRoutedWriters when record options are set should route before them", func(t *testing.T) {
+		sampled, unsampled, audit := &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}
+		Configure(func(cfg *LoggerConfig) {
+			cfg.WithTraceRoutedWriters(sampled, unsampled)
+			cfg.WithMaxFields(4)
+			cfg.WithFieldRoutedWriter("audit", "true", audit)
+		})
+
+		Info(sampledCtx).Bool("audit", true).Msg("routed")
+
+		assert.Contains(t, sampled.String(), "\"message\":\"routed\"")
+		assert.NotContains(t, sampled.String(), "fields_truncated")
+		assert.Empty(t, unsampled.String())
+		assert.Contains(t, audit.String(), "\"message\":\"routed\"")
+		assert.NotContains(t, audit.String(), traceSampledFieldName)
+	})
+}
@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceparentLength = 55 // Length of a version "00" traceparent, such as "00-<trace-id>-<parent-id>-<flags>".
+
+// WithTraceparentHeader makes the HTTP middleware store the span context of the W3C 'traceparent' request header
+// into the request context as a remote span context, so the 'trace_id' and 'span_id' fields of TraceFields are written
+// even before any span is created. Malformed headers are ignored, as are requests whose context already carries a valid span context.
+//
+// Example usage:
+//
+//	handler := logger.HTTPMiddleware(logger.WithTraceparentHeader())(mux)
+//
+// Returns:
+//
+//	HTTPMiddlewareOption: The option to be passed to HTTPMiddleware.
+func WithTraceparentHeader() HTTPMiddlewareOption {
+	return func(cfg *httpMiddlewareConfig) {
+		cfg.traceparent = true
+	}
+}
+
+// traceparentContext returns a copy of the request context carrying the span context of the 'traceparent' request header.
+func traceparentContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if _, ok := spanContext(ctx); ok {
+		return ctx
+	}
+
+	sc, ok := parseTraceparent(r.Header.Get("traceparent"))
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// parseTraceparent parses a W3C traceparent header. Versions after "00" are parsed by their known prefix, as the specification requires.
+func parseTraceparent(h string) (trace.SpanContext, bool) {
+	if len(h) < traceparentLength || h[2] != '-' || h[35] != '-' || h[52] != '-' {
+		return trace.SpanContext{}, false
+	}
+
+	version, err := hex.DecodeString(h[:2])
+	if err != nil || version[0] == 0xff || (version[0] == 0 && len(h) != traceparentLength) {
+		return trace.SpanContext{}, false
+	}
+	if len(h) > traceparentLength && h[traceparentLength] != '-' {
+		return trace.SpanContext{}, false
+	}
+
+	traceID, err := trace.TraceIDFromHex(h[3:35])
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	spanID, err := trace.SpanIDFromHex(h[36:52])
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	flags, err := hex.DecodeString(h[53:55])
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.TraceFlags(flags[0]),
+		Remote:     true,
+	})
+	return sc, sc.IsValid()
+}
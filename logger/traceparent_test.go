@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTraceparentHeader(t *testing.T) {
+	buff := &lockedBuffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(TraceFields())
+	})
+
+	handler := HTTPMiddleware(WithTraceparentHeader())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Info(r.Context()).Msg("handled")
+	}))
+
+	serve := func(traceparent string) string {
+		buff.Reset()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("traceparent", traceparent)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		return strings.SplitN(buff.String(), "\n", 2)[0]
+	}
+
+	t.Run("HTTPMiddleware when traceparent is valid should write the trace fields", func(t *testing.T) {
+		line := serve("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+		assert.Contains(t, line, "\"trace_id\":\"4bf92f3577b34da6a3ce929d0e0e4736\"")
+		assert.Contains(t, line, "\"span_id\":\"00f067aa0ba902b7\"")
+	})
+
+	invalid := map[string]string{
+		"malformed":       "not-a-traceparent",
+		"uppercase":       "00-4BF92F3577B34DA6A3CE929D0E0E4736-00F067AA0BA902B7-01",
+		"zero trace id":   "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"invalid version": "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"trailing data":   "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	}
+
+	for name, traceparent := range invalid {
+		t.Run("HTTPMiddleware when traceparent is "+name+" should not write the trace fields", func(t *testing.T) {
+			assert.NotContains(t, serve(traceparent), "trace_id")
+		})
+	}
+}
+
+func TestParseTraceparent(t *testing.T) {
+	t.Run("parseTraceparent when version is newer should parse the known prefix", func(t *testing.T) {
+		sc, ok := parseTraceparent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra")
+
+		assert.True(t, ok)
+		assert.True(t, sc.IsSampled())
+		assert.True(t, sc.IsRemote())
+	})
+}
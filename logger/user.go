@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+type userCtxKey struct{}
+
+type user struct {
+	id         string
+	role       string
+	authMethod string
+}
+
+// WithUser stores the authenticated user details into the context, written by the UserFields event option
+// as the 'user_id', 'user_role' and 'auth_method' fields on every log event created with the returned context.
+// Since the details are read from the context rather than from the context logger, they are kept when WithContext
+// or Derive are called afterwards. Empty details are omitted.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.UserFields())
+//	ctx = logger.WithUser(ctx, claims.Subject, claims.Role, "oidc")
+//	logger.Info(ctx).Msg("invoice exported") // Includes the 'user_id', 'user_role' and 'auth_method' fields.
+//
+// Params:
+//
+//	ctx (context.Context): The context in which the user details are stored.
+//	userID (string): The identifier of the authenticated user.
+//	role (string): The role of the authenticated user.
+//	authMethod (string): The method used to authenticate the user, such as "password" or "oidc".
+//
+// Returns:
+//
+//	context.Context: A copy of ctx carrying the user details.
+func WithUser(ctx context.Context, userID, role, authMethod string) context.Context {
+	return context.WithValue(ctx, userCtxKey{}, user{id: userID, role: role, authMethod: authMethod})
+}
+
+// UserFields returns an event option that writes the user details stored into the context by WithUser
+// as the 'user_id', 'user_role' and 'auth_method' fields. Log events created with a context without user details are not changed.
+//
+// Example usage:
+//
+//	cfg.WithEventFields(logger.UserFields())
+//
+// Returns:
+//
+//	LogEventOption: The event option writing the user fields.
+func UserFields() LogEventOption {
+	return func(ctx context.Context, e *zerolog.Event) *zerolog.Event {
+		u, ok := ctx.Value(userCtxKey{}).(user)
+		if !ok {
+			return e
+		}
+
+		for _, f := range []struct{ key, value string }{
+			{key: "user_id", value: u.id},
+			{key: "user_role", value: u.role},
+			{key: "auth_method", value: u.authMethod},
+		} {
+			if f.value != "" {
+				e = e.Str(f.key, f.value)
+			}
+		}
+		return e
+	}
+}
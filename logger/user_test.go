@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithUser(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+		cfg.WithEventFields(UserFields())
+	})
+
+	suts := map[string]struct {
+		ctx    context.Context
+		assert func(t *testing.T, msg string)
+	}{
+		"WithUser when context carries the user should write the user fields": {
+			ctx: WithUser(context.TODO(), "42", "admin", "oidc"),
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"user_id\":\"42\"")
+				assert.Contains(t, msg, "\"user_role\":\"admin\"")
+				assert.Contains(t, msg, "\"auth_method\":\"oidc\"")
+			},
+		},
+		"WithUser when details are empty should omit them": {
+			ctx: WithUser(context.TODO(), "42", "", ""),
+			assert: func(t *testing.T, msg string) {
+				assert.Contains(t, msg, "\"user_id\":\"42\"")
+				assert.NotContains(t, msg, "user_role")
+				assert.NotContains(t, msg, "auth_method")
+			},
+		},
+		"WithUser when context does not carry the user should not write the user fields": {
+			ctx: context.TODO(),
+			assert: func(t *testing.T, msg string) {
+				assert.NotContains(t, msg, "user_id")
+			},
+		},
+	}
+
+	for name, sut := range suts {
+		t.Run(name, func(t *testing.T) {
+			buff.Reset()
+
+			Info(sut.ctx).Msg("audited")
+
+			sut.assert(t, buff.String())
+		})
+	}
+
+	t.Run("WithContext when called after WithUser should keep the user fields", func(t *testing.T) {
+		buff.Reset()
+		ctx := WithContext(WithUser(context.TODO(), "42", "admin", "oidc"))
+
+		Info(ctx).Msg("audited")
+
+		assert.Contains(t, buff.String(), "\"user_id\":\"42\"")
+		assert.Contains(t, buff.String(), "\"user_role\":\"admin\"")
+		assert.Contains(t, buff.String(), "\"auth_method\":\"oidc\"")
+	})
+}
@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"context"
+	"sort"
+
+	"github.com/rs/zerolog"
+)
+
+// ValidationErrors starts a new logging event describing the validation of an input, such as a request payload.
+// The failures are logged at the "warn" level as the 'validation_errors' array of {"field", "reason"} objects, sorted by field,
+// or at the "info" level with the 'valid' field set to true when there are none.
+// The reasons of the fields redacted by the WithRedactionPolicy of the context are masked, since they may quote the invalid value.
+// It returns a *zerolog.Event that is not sent until the Msg method is called.
+//
+// Example usage:
+//
+//	logger.ValidationErrors(ctx, map[string]string{"email": "must be a valid email"}).Msg("invalid signup")
+//	// "validation_errors":[{"field":"email","reason":"must be a valid email"}]
+//
+// Params:
+//
+//	ctx (context.Context): The context from which to extract tracing information.
+//	failures (map[string]string): The reasons of the validation failures, by field.
+//
+// Returns:
+//
+//	*zerolog.Event: A pointer to the log event. Ensure to call Msg to emit the log.
+func ValidationErrors(ctx context.Context, failures map[string]string) *zerolog.Event {
+	if len(failures) == 0 {
+		e := newEvent(ctx, zerolog.InfoLevel).Bool("valid", true)
+
+		return event(ctx, e)
+	}
+
+	fields := make([]string, 0, len(failures))
+	for field := range failures {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	policy, _ := ctx.Value(redactionCtxKey{}).(map[string]struct{})
+
+	arr := zerolog.Arr()
+	for _, field := range fields {
+		reason := failures[field]
+		if _, ok := policy[field]; ok {
+			reason = secretMask
+		}
+		arr = arr.Dict(zerolog.Dict().Str("field", field).Str("reason", reason))
+	}
+
+	e := newEvent(ctx, zerolog.WarnLevel).Array("validation_errors", arr)
+
+	return event(ctx, e)
+}
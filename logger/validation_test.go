@@ -0,0 +1,57 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationErrors(t *testing.T) {
+	buff := &bytes.Buffer{}
+	Configure(func(cfg *LoggerConfig) {
+		cfg.WithWriter(buff)
+	})
+
+	fields := func() map[string]any {
+		var fields map[string]any
+		assert.NoError(t, json.Unmarshal(buff.Bytes(), &fields))
+		buff.Reset()
+		return fields
+	}
+
+	t.Run("ValidationErrors when there are failures should log them at the warn level", func(t *testing.T) {
+		ValidationErrors(context.TODO(), map[string]string{
+			"name":  "is required",
+			"email": "must be a valid email",
+		}).Msg("invalid signup")
+
+		f := fields()
+		assert.Equal(t, "warn", f["level"])
+		assert.Equal(t, []any{
+			map[string]any{"field": "email", "reason": "must be a valid email"},
+			map[string]any{"field": "name", "reason": "is required"},
+		}, f["validation_errors"])
+		assert.NotContains(t, f, "valid")
+	})
+
+	t.Run("ValidationErrors when there are no failures should log valid at the info level", func(t *testing.T) {
+		ValidationErrors(context.TODO(), map[string]string{}).Msg("valid signup")
+
+		f := fields()
+		assert.Equal(t, "info", f["level"])
+		assert.Equal(t, true, f["valid"])
+		assert.NotContains(t, f, "validation_errors")
+	})
+
+	t.Run("ValidationErrors when field is redacted by the context policy should mask the reason", func(t *testing.T) {
+		ctx := WithRedactionPolicy(context.TODO(), []string{"password"})
+		ValidationErrors(ctx, map[string]string{"password": "hunter2 is too short"}).Msg("invalid signup")
+
+		assert.Equal(t, []any{
+			map[string]any{"field": "password", "reason": "[REDACTED]"},
+		}, fields()["validation_errors"])
+	})
+}
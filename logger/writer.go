@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+// Sync flushes the buffered log events of the configured writers, such as compressed files.
+//
+// Example usage:
+//
+//	defer logger.Sync()
+//
+// Returns:
+//
+//	error: The errors returned by the writers, if any.
+func Sync() error {
+	return config().sync()
+}
+
+// Shutdown flushes the buffered log events and closes the configured writers, such as compressed files,
+// so they are left in a consistent state. The global logger must not be used after Shutdown.
+// It returns the context error if the context is done before all writers are closed.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	logger.Shutdown(ctx)
+//
+// Params:
+//
+//	ctx (context.Context): The context bounding the shutdown.
+//
+// Returns:
+//
+//	error: The errors returned by the writers, if any.
+func Shutdown(ctx context.Context) error {
+	return config().shutdown(ctx)
+}
+
+// sync flushes every writer chain.
+func (cfg *LoggerConfig) sync() error {
+	cfg.mu.Lock()
+	outs := []io.Writer{cfg.out}
+	for _, w := range cfg.formatOuts {
+		outs = append(outs, w)
+	}
+	cfg.mu.Unlock()
+
+	errs := make([]error, len(outs))
+	for i, w := range outs {
+		errs[i] = syncWriter(w)
+	}
+	return errors.Join(errs...)
+}
+
+func (cfg *LoggerConfig) shutdown(ctx context.Context) error {
+	errs := []error{cfg.sync()}
+
+	// The writers are closed without holding the lock, since closing them may write log events.
+	cfg.mu.Lock()
+	closers := cfg.closers
+	cfg.closers = nil
+	cfg.mu.Unlock()
+
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			cfg.mu.Lock()
+			cfg.closers = append(closers[:i+1:i+1], cfg.closers...)
+			cfg.mu.Unlock()
+			return errors.Join(append(errs, err)...)
+		}
+		errs = append(errs, closers[i].Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+func levelWriter(w io.Writer) zerolog.LevelWriter {
+	if lw, ok := w.(zerolog.LevelWriter); ok {
+		return lw
+	}
+	return zerolog.LevelWriterAdapter{Writer: w}
+}
+
+func writeLevel(w io.Writer, level zerolog.Level, p []byte) (int, error) {
+	if lw, ok := w.(zerolog.LevelWriter); ok {
+		return lw.WriteLevel(level, p)
+	}
+	return w.Write(p)
+}
+
+// syncWriter flushes the writer, when supported, so buffered messages reach their destination.
+// The zerolog writer adapters are unwrapped to reach the underlying writer.
+func syncWriter(w io.Writer) error {
+	switch f := w.(type) {
+	case zerolog.LevelWriterAdapter:
+		return syncWriter(f.Writer)
+	case zerolog.ConsoleWriter:
+		return syncWriter(f.Out)
+	case interface{ Sync() error }:
+		return f.Sync()
+	}
+	return nil
+}
+
+// writeError reports a write error the same way zerolog does.
+func writeError(err error) {
+	if zerolog.ErrorHandler != nil {
+		zerolog.ErrorHandler(err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "zerolog: could not write event: %v\n", err)
+}